@@ -0,0 +1,349 @@
+package slices
+
+import (
+	"fmt"
+	"iter"
+)
+
+// ChunkReduce divides s into consecutive chunks of length size
+// (the last of which may be shorter),
+// maps each chunk to an intermediate result with mapf,
+// and folds those results together with reducef, starting from init.
+//
+// For the result to be independent of how s is chunked,
+// reducef should be associative
+// and init should be an identity value for it
+// (so that reducef(init, x) == x).
+// If s is empty, the result is init.
+//
+// Each chunk is a subslice of s, not a copy.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// ChunkReduce panics if size < 1.
+func ChunkReduce[T, U any](s []T, size int, mapf func([]T) U, reducef func(a, b U) U, init U) U {
+	if size < 1 {
+		panic("slices: chunk size must be positive")
+	}
+	result := init
+	for len(s) > 0 {
+		n := min(size, len(s))
+		result = reducef(result, mapf(s[:n:n]))
+		s = s[n:]
+	}
+	return result
+}
+
+// OverlappingChunks returns an iterator over chunks of s of length size,
+// each of which shares its first overlap elements with the end of the previous chunk.
+// That is, consecutive chunks begin size-overlap elements apart.
+//
+// The final chunk may be shorter than size.
+// It is produced only if it contains elements not in the previous chunk.
+//
+// Each chunk is a subslice of s, not a copy.
+//
+// It is an error for size to be less than 1,
+// or for overlap to be negative or not less than size.
+//
+// Example: OverlappingChunks([a, b, c, d, e, f], 3, 1) -> [a, b, c], [c, d, e], [e, f]
+func OverlappingChunks[T any](s []T, size, overlap int) (iter.Seq[[]T], error) {
+	if size < 1 {
+		return nil, fmt.Errorf("chunk size %d is not positive", size)
+	}
+	if overlap < 0 || overlap >= size {
+		return nil, fmt.Errorf("overlap %d is out of range for chunk size %d", overlap, size)
+	}
+	step := size - overlap
+	return func(yield func([]T) bool) {
+		for i := 0; i < len(s); i += step {
+			end := min(i+size, len(s))
+			if !yield(s[i:end]) || end == len(s) {
+				return
+			}
+		}
+	}, nil
+}
+
+// ChunkOffsets returns the starting index of each chunk
+// when a slice of length total is divided into consecutive chunks of length size
+// (the last of which may be shorter).
+// Chunk i covers the indices from the i'th offset up to but not including min(offset+size, total).
+//
+// If total <= 0 the result is empty.
+//
+// ChunkOffsets panics if size < 1.
+//
+// Example: ChunkOffsets(10, 4) -> [0, 4, 8]
+func ChunkOffsets(total, size int) []int {
+	if size < 1 {
+		panic("slices: chunk size must be positive")
+	}
+	if total <= 0 {
+		return nil
+	}
+	result := make([]int, 0, (total+size-1)/size)
+	for i := 0; i < total; i += size {
+		result = append(result, i)
+	}
+	return result
+}
+
+// Range is a half-open range of indices, [Start, End).
+type Range struct {
+	Start, End int
+}
+
+// ChunkRanges returns the bounds of each chunk
+// when a slice of length total is divided into consecutive chunks of length size
+// (the last of which may be shorter).
+// No slice is needed,
+// so the same bounds can be applied to several parallel slices.
+//
+// If total <= 0 the result is empty.
+//
+// ChunkRanges panics if size < 1.
+//
+// Example: ChunkRanges(10, 4) -> [{0, 4}, {4, 8}, {8, 10}]
+func ChunkRanges(total, size int) []Range {
+	offsets := ChunkOffsets(total, size)
+	if len(offsets) == 0 {
+		return nil
+	}
+	result := make([]Range, len(offsets))
+	for i, start := range offsets {
+		result[i] = Range{Start: start, End: min(start+size, total)}
+	}
+	return result
+}
+
+// ChunkByDynamicSize divides s into consecutive chunks
+// whose lengths are chosen by nextSize.
+// Before each chunk, nextSize is called with the number of elements remaining,
+// and returns the length of the next chunk.
+// A length greater than the number remaining is truncated.
+// If nextSize returns a length less than 1,
+// chunking stops and all the remaining elements form the final chunk.
+//
+// Each chunk is a subslice of s, not a copy.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// Example: ChunkByDynamicSize([a, b, c, d, e, f, g], doubling from 1) -> [[a], [b, c], [d, e, f, g]]
+func ChunkByDynamicSize[T any](s []T, nextSize func(remaining int) int) [][]T {
+	var result [][]T
+	for len(s) > 0 {
+		n := nextSize(len(s))
+		if n < 1 || n > len(s) {
+			n = len(s)
+		}
+		result = append(result, s[:n:n])
+		s = s[n:]
+	}
+	return result
+}
+
+// IndexedChunks divides s into consecutive chunks of length size
+// (the last of which may be shorter)
+// and returns a map from each chunk's 0-based position to the chunk.
+// This is convenient when chunks are processed out of order
+// and must be matched up again afterward.
+//
+// Each chunk is a subslice of s, not a copy.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// IndexedChunks panics if size < 1.
+//
+// Example: IndexedChunks([a, b, c, d, e], 2) -> {0: [a, b], 1: [c, d], 2: [e]}
+func IndexedChunks[T any](s []T, size int) map[int][]T {
+	if size < 1 {
+		panic("slices: chunk size must be positive")
+	}
+	result := make(map[int][]T)
+	for i := 0; len(s) > 0; i++ {
+		n := min(size, len(s))
+		result[i] = s[:n:n]
+		s = s[n:]
+	}
+	return result
+}
+
+// ColumnChunks distributes the elements of s round-robin into cols new slices,
+// so that s[i] goes into result[i%cols],
+// as when dealing cards to players.
+//
+// If len(s) is not a multiple of cols,
+// the first len(s)%cols slices have one more element than the others.
+// If len(s) < cols, some slices are empty.
+//
+// ColumnChunks panics if cols < 1.
+//
+// Example: ColumnChunks([a, b, c, d, e], 2) -> [[a, c, e], [b, d]]
+func ColumnChunks[T any](s []T, cols int) [][]T {
+	if cols < 1 {
+		panic("slices: column count must be positive")
+	}
+	result := make([][]T, cols)
+	for j := range result {
+		result[j] = make([]T, 0, (len(s)-j+cols-1)/cols)
+	}
+	for i, val := range s {
+		result[i%cols] = append(result[i%cols], val)
+	}
+	return result
+}
+
+// Deinterleave separates s into n new slices,
+// where s consists of n interleaved sequences:
+// s[i] goes into result[i%n].
+// It is the same as ColumnChunks,
+// but returns an error instead of panicking if n < 1.
+//
+// If len(s) is not a multiple of n,
+// the first len(s)%n slices have one more element than the others.
+//
+// Example: Deinterleave([1, a, 2, b, 3], 2) -> [[1, 2, 3], [a, b]]
+func Deinterleave[T any](s []T, n int) ([][]T, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("count %d is not positive", n)
+	}
+	return ColumnChunks(s, n), nil
+}
+
+// ChunkSeqOf returns an iterator over consecutive batches of size elements taken from seq.
+// The final batch may be shorter.
+// Batches are gathered lazily, as the result is iterated over.
+//
+// Since seq is not a slice,
+// each batch is a newly allocated slice
+// that the caller may retain or modify.
+//
+// ChunkSeqOf panics if size < 1.
+func ChunkSeqOf[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("slices: chunk size must be positive")
+	}
+	return func(yield func([]T) bool) {
+		var batch []T
+		for val := range seq {
+			if batch == nil {
+				batch = make([]T, 0, size)
+			}
+			batch = append(batch, val)
+			if len(batch) == size {
+				if !yield(batch) {
+					return
+				}
+				batch = nil
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
+
+// ChunkBalanced divides s into about targetChunks consecutive chunks of nearly equal length,
+// none longer than maxSize.
+// If honoring maxSize requires more than targetChunks chunks,
+// the cap wins and the number of chunks is increased as needed.
+// There are never more chunks than elements, so no chunk is empty.
+//
+// When the elements don't divide evenly,
+// the leftover elements go one apiece to the earliest chunks,
+// so chunk lengths differ by at most one.
+//
+// A targetChunks less than 1 is treated as 1.
+// A maxSize less than 1 means there is no cap.
+//
+// Each chunk is a subslice of s, not a copy.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// Example: ChunkBalanced([a, b, c, d, e, f, g], 2, 3) -> [[a, b, c], [d, e], [f, g]]
+func ChunkBalanced[T any](s []T, targetChunks, maxSize int) [][]T {
+	if len(s) == 0 {
+		return nil
+	}
+	n := max(targetChunks, 1)
+	if maxSize >= 1 {
+		n = max(n, (len(s)+maxSize-1)/maxSize)
+	}
+	n = min(n, len(s))
+
+	result := make([][]T, 0, n)
+	size, extra := len(s)/n, len(s)%n
+	for i := range n {
+		k := size
+		if i < extra {
+			k++
+		}
+		result = append(result, s[:k:k])
+		s = s[k:]
+	}
+	return result
+}
+
+// ChunkUntil divides s into consecutive chunks,
+// deciding where each chunk ends with shouldFlush.
+// For each element after the first in a chunk,
+// shouldFlush is called with the chunk so far and the element;
+// if it returns true, the chunk is closed before the element,
+// which then begins a new chunk.
+// The final chunk is always included in the result.
+//
+// Each chunk is a subslice of s, not a copy,
+// as is the current argument to shouldFlush,
+// which must not modify or retain it.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// Example: ChunkUntil([3, 4, 2, 5, 1], sum of current and next > 7) -> [[3, 4], [2, 5], [1]]
+func ChunkUntil[T any](s []T, shouldFlush func(current []T, next T) bool) [][]T {
+	if len(s) == 0 {
+		return nil
+	}
+	var (
+		result [][]T
+		start  int
+	)
+	for i := 1; i < len(s); i++ {
+		if shouldFlush(s[start:i:i], s[i]) {
+			result = append(result, s[start:i:i])
+			start = i
+		}
+	}
+	return append(result, s[start:])
+}
+
+// ChunkRight divides s into consecutive chunks of length size,
+// aligned to the end of s rather than the beginning.
+// If size does not divide len(s),
+// it is the first chunk that is shorter, not the last.
+// (Left-aligned chunking of [a, b, c, d, e] by 2 gives [[a, b], [c, d], [e]];
+// ChunkRight gives [[a], [b, c], [d, e]].)
+//
+// Each chunk is a subslice of s, not a copy.
+// The capacity of each chunk is limited to its length,
+// so appending to one does not overwrite the next.
+//
+// ChunkRight panics if size < 1.
+func ChunkRight[T any](s []T, size int) [][]T {
+	if size < 1 {
+		panic("slices: chunk size must be positive")
+	}
+	if len(s) == 0 {
+		return nil
+	}
+	result := make([][]T, 0, (len(s)+size-1)/size)
+	if n := len(s) % size; n > 0 {
+		result = append(result, s[:n:n])
+		s = s[n:]
+	}
+	for len(s) > 0 {
+		result = append(result, s[:size:size])
+		s = s[size:]
+	}
+	return result
+}
@@ -0,0 +1,365 @@
+package slices
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"testing"
+)
+
+func TestChunkReduce(t *testing.T) {
+	cases := []struct {
+		inp        []int
+		size, want int
+	}{{
+		inp: nil, size: 2, want: 0,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, size: 2, want: 15,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, size: 10, want: 15,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			var calls int
+			sum := func(chunk []int) int {
+				calls++
+				var result int
+				for _, n := range chunk {
+					result += n
+				}
+				return result
+			}
+			got := ChunkReduce(tc.inp, tc.size, sum, func(a, b int) int { return a + b }, 0)
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+			if wantCalls := (len(tc.inp) + tc.size - 1) / tc.size; calls != wantCalls {
+				t.Errorf("got %d calls to mapf, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
+
+func TestOverlappingChunks(t *testing.T) {
+	cases := []struct {
+		inp           []int
+		size, overlap int
+		want          [][]int
+		wantErr       bool
+	}{{
+		inp: nil, size: 3, overlap: 1, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4, 5, 6}, size: 3, overlap: 1, want: [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}},
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, size: 3, overlap: 1, want: [][]int{{1, 2, 3}, {3, 4, 5}},
+	}, {
+		inp: []int{1, 2, 3, 4}, size: 2, overlap: 0, want: [][]int{{1, 2}, {3, 4}},
+	}, {
+		inp: []int{1, 2}, size: 5, overlap: 2, want: [][]int{{1, 2}},
+	}, {
+		inp: []int{1, 2}, size: 2, overlap: 2, wantErr: true,
+	}, {
+		inp: []int{1, 2}, size: 2, overlap: -1, wantErr: true,
+	}, {
+		inp: []int{1, 2}, size: 0, overlap: 0, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			seq, err := OverlappingChunks(tc.inp, tc.size, tc.overlap)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got [][]int
+			for chunk := range seq {
+				got = append(got, chunk)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChunkOffsets(t *testing.T) {
+	cases := []struct {
+		total, size int
+		want        []int
+	}{{
+		total: 0, size: 4, want: nil,
+	}, {
+		total: 10, size: 4, want: []int{0, 4, 8},
+	}, {
+		total: 8, size: 4, want: []int{0, 4},
+	}, {
+		total: 3, size: 4, want: []int{0},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkOffsets(tc.total, tc.size)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChunkByDynamicSize(t *testing.T) {
+	doubling := func() func(int) int {
+		size := 1
+		return func(int) int {
+			result := size
+			size *= 2
+			return result
+		}
+	}
+
+	cases := []struct {
+		inp      []int
+		nextSize func(int) int
+		want     [][]int
+	}{{
+		inp: nil, nextSize: doubling(), want: nil,
+	}, {
+		inp:      []int{1, 2, 3, 4, 5, 6, 7},
+		nextSize: doubling(),
+		want:     [][]int{{1}, {2, 3}, {4, 5, 6, 7}},
+	}, {
+		inp:      []int{1, 2, 3, 4, 5},
+		nextSize: doubling(),
+		want:     [][]int{{1}, {2, 3}, {4, 5}},
+	}, {
+		inp:      []int{1, 2, 3, 4, 5},
+		nextSize: func(remaining int) int { return remaining - 3 },
+		want:     [][]int{{1, 2}, {3, 4, 5}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkByDynamicSize(tc.inp, tc.nextSize)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIndexedChunks(t *testing.T) {
+	got := IndexedChunks([]int{1, 2, 3, 4, 5}, 2)
+	want := map[int][]int{0: {1, 2}, 1: {3, 4}, 2: {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := IndexedChunks([]int(nil), 2); len(got) != 0 {
+		t.Errorf("got %v, want empty map", got)
+	}
+
+	_ = append(got[0], 9)
+	if !reflect.DeepEqual(got[1], []int{3, 4}) {
+		t.Errorf("appending to the first chunk changed the second to %v", got[1])
+	}
+}
+
+func TestColumnChunks(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		cols int
+		want [][]int
+	}{{
+		inp: nil, cols: 2, want: [][]int{{}, {}},
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, cols: 2, want: [][]int{{1, 3, 5}, {2, 4}},
+	}, {
+		inp: []int{1, 2, 3, 4, 5, 6}, cols: 3, want: [][]int{{1, 4}, {2, 5}, {3, 6}},
+	}, {
+		inp: []int{1, 2}, cols: 3, want: [][]int{{1}, {2}, {}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ColumnChunks(tc.inp, tc.cols)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChunkSeqOf(t *testing.T) {
+	count := func(n int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for i := 1; i <= n; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+
+	cases := []struct {
+		n, size int
+		want    [][]int
+	}{{
+		n: 0, size: 2, want: nil,
+	}, {
+		n: 5, size: 2, want: [][]int{{1, 2}, {3, 4}, {5}},
+	}, {
+		n: 4, size: 2, want: [][]int{{1, 2}, {3, 4}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			var got [][]int
+			for batch := range ChunkSeqOf(count(tc.n), tc.size) {
+				got = append(got, batch)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChunkBalanced(t *testing.T) {
+	cases := []struct {
+		inp                   []int
+		targetChunks, maxSize int
+		want                  [][]int
+	}{{
+		inp: nil, targetChunks: 2, maxSize: 3, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4, 5, 6, 7}, targetChunks: 2, maxSize: 3, want: [][]int{{1, 2, 3}, {4, 5}, {6, 7}},
+	}, {
+		inp: []int{1, 2, 3, 4, 5, 6, 7}, targetChunks: 2, maxSize: 0, want: [][]int{{1, 2, 3, 4}, {5, 6, 7}},
+	}, {
+		inp: []int{1, 2, 3}, targetChunks: 5, maxSize: 10, want: [][]int{{1}, {2}, {3}},
+	}, {
+		inp: []int{1, 2, 3}, targetChunks: 0, maxSize: 0, want: [][]int{{1, 2, 3}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkBalanced(tc.inp, tc.targetChunks, tc.maxSize)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChunkUntil(t *testing.T) {
+	wouldExceed7 := func(current []int, next int) bool {
+		sum := next
+		for _, n := range current {
+			sum += n
+		}
+		return sum > 7
+	}
+
+	cases := []struct {
+		inp  []int
+		want [][]int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []int{3, 4, 2, 5, 1}, want: [][]int{{3, 4}, {2, 5}, {1}},
+	}, {
+		inp: []int{9, 9}, want: [][]int{{9}, {9}},
+	}, {
+		inp: []int{1, 2, 3}, want: [][]int{{1, 2, 3}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkUntil(tc.inp, wouldExceed7)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	got := ChunkUntil([]int{3, 4, 2, 5, 1}, wouldExceed7)
+	_ = append(got[0], 9)
+	if !reflect.DeepEqual(got[1], []int{2, 5}) {
+		t.Errorf("appending to the first chunk changed the second to %v", got[1])
+	}
+}
+
+func TestChunkRight(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		size int
+		want [][]int
+	}{{
+		inp: nil, size: 2, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, size: 2, want: [][]int{{1}, {2, 3}, {4, 5}},
+	}, {
+		inp: []int{1, 2, 3, 4}, size: 2, want: [][]int{{1, 2}, {3, 4}},
+	}, {
+		inp: []int{1, 2}, size: 5, want: [][]int{{1, 2}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkRight(tc.inp, tc.size)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	got := ChunkRight([]int{1, 2, 3, 4, 5}, 2)
+	_ = append(got[0], 9)
+	if !reflect.DeepEqual(got[1], []int{2, 3}) {
+		t.Errorf("appending to the first chunk changed the second to %v", got[1])
+	}
+}
+
+func TestDeinterleave(t *testing.T) {
+	got, err := Deinterleave([]string{"1", "a", "2", "b", "3"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"1", "2", "3"}, {"a", "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := Deinterleave([]string{"1"}, 0); err == nil {
+		t.Error("got no error for n == 0, want one")
+	}
+}
+
+func TestChunkRanges(t *testing.T) {
+	cases := []struct {
+		total, size int
+		want        []Range
+	}{{
+		total: 0, size: 4, want: nil,
+	}, {
+		total: 10, size: 4, want: []Range{{0, 4}, {4, 8}, {8, 10}},
+	}, {
+		total: 8, size: 4, want: []Range{{0, 4}, {4, 8}},
+	}, {
+		total: 3, size: 4, want: []Range{{0, 3}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ChunkRanges(tc.total, tc.size)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
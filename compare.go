@@ -0,0 +1,106 @@
+package slices
+
+// EqualCyclic tells whether a and b contain the same cyclic sequence of elements,
+// i.e. whether b is some rotation of a (including the rotation by 0).
+// The relation is symmetric.
+//
+// Slices of different lengths are never cyclically equal.
+// Two empty slices are.
+//
+// In the worst case this takes time proportional to len(a)*len(a).
+//
+// Example: EqualCyclic([a, b, c], [c, a, b]) -> true
+func EqualCyclic[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+
+outer:
+	for r := 0; r < len(a); r++ {
+		for i, val := range b {
+			if a[(i+r)%len(a)] != val {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// EqualPrefix tells whether the first n elements of a and b are equal.
+// If either slice has fewer than n elements, the result is false.
+// If n <= 0, the result is true.
+//
+// Example: EqualPrefix([a, b, c], [a, b, d], 2) -> true
+func EqualPrefix[T comparable](a, b []T, n int) bool {
+	return EqualPrefixFunc(a, b, n, func(x, y T) bool { return x == y })
+}
+
+// EqualPrefixFunc tells whether the first n elements of a and b are equal,
+// using eq to compare corresponding elements.
+// If either slice has fewer than n elements, the result is false.
+// If n <= 0, the result is true.
+func EqualPrefixFunc[T any](a, b []T, n int, eq func(x, y T) bool) bool {
+	if n <= 0 {
+		return true
+	}
+	if len(a) < n || len(b) < n {
+		return false
+	}
+	for i := range n {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllEqual tells whether every element of s is equal to every other.
+// It is vacuously true for slices with fewer than two elements.
+func AllEqual[T comparable](s []T) bool {
+	return AllEqualFunc(s, func(a, b T) bool { return a == b })
+}
+
+// AllEqualFunc tells whether every element of s is equal to the first,
+// using eq to compare them.
+// It stops at the first element that is not.
+// It is vacuously true for slices with fewer than two elements.
+func AllEqualFunc[T any](s []T, eq func(a, b T) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if !eq(s[0], s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MismatchIndex returns the first index at which a and b differ.
+// There are three cases:
+//
+//   - if some a[i] != b[i], the result is the smallest such i;
+//   - otherwise, if one slice is shorter (so it is a prefix of the other),
+//     the result is the length of the shorter slice;
+//   - otherwise the slices are equal and the result is -1.
+//
+// Example: MismatchIndex([a, b, c], [a, x, c]) -> 1
+func MismatchIndex[T comparable](a, b []T) int {
+	return MismatchIndexFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// MismatchIndexFunc is like MismatchIndex
+// but uses eq to compare corresponding elements.
+func MismatchIndexFunc[T any](a, b []T, eq func(x, y T) bool) int {
+	n := min(len(a), len(b))
+	for i := range n {
+		if !eq(a[i], b[i]) {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return n
+	}
+	return -1
+}
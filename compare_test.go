@@ -0,0 +1,118 @@
+package slices
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEqualCyclic(t *testing.T) {
+	cases := []struct {
+		a, b []int
+		want bool
+	}{{
+		a: nil, b: nil, want: true,
+	}, {
+		a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: true,
+	}, {
+		a: []int{1, 2, 3}, b: []int{3, 1, 2}, want: true,
+	}, {
+		a: []int{1, 2, 3}, b: []int{2, 3, 1}, want: true,
+	}, {
+		a: []int{1, 2, 3}, b: []int{3, 2, 1}, want: false,
+	}, {
+		a: []int{1, 2, 3}, b: []int{1, 2}, want: false,
+	}, {
+		a: []int{1, 1, 2}, b: []int{1, 2, 2}, want: false,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := EqualCyclic(tc.a, tc.b); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if got := EqualCyclic(tc.b, tc.a); got != tc.want {
+				t.Errorf("reversed args: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqualPrefix(t *testing.T) {
+	cases := []struct {
+		a, b []int
+		n    int
+		want bool
+	}{{
+		a: nil, b: nil, n: 0, want: true,
+	}, {
+		a: nil, b: nil, n: 1, want: false,
+	}, {
+		a: []int{1, 2, 3}, b: []int{1, 2, 4}, n: 2, want: true,
+	}, {
+		a: []int{1, 2, 3}, b: []int{1, 2, 4}, n: 3, want: false,
+	}, {
+		a: []int{1, 2, 3}, b: []int{1, 2}, n: 3, want: false,
+	}, {
+		a: []int{1, 2}, b: []int{1, 2, 3}, n: 2, want: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := EqualPrefix(tc.a, tc.b, tc.n); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAllEqual(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		want bool
+	}{{
+		inp: nil, want: true,
+	}, {
+		inp: []int{1}, want: true,
+	}, {
+		inp: []int{1, 1, 1}, want: true,
+	}, {
+		inp: []int{1, 1, 2}, want: false,
+	}, {
+		inp: []int{2, 1, 1}, want: false,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := AllEqual(tc.inp); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMismatchIndex(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{{
+		a: "", b: "", want: -1,
+	}, {
+		a: "abc", b: "abc", want: -1,
+	}, {
+		a: "abc", b: "axc", want: 1,
+	}, {
+		a: "ab", b: "abc", want: 2,
+	}, {
+		a: "abc", b: "", want: 0,
+	}, {
+		a: "xbc", b: "ab", want: 0,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := MismatchIndex([]byte(tc.a), []byte(tc.b)); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ForEachChunkConcurrent divides s into consecutive chunks of length chunkSize
+// (the last of which may be shorter)
+// and calls f on each chunk using a pool of workers goroutines.
+//
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+// If chunkSize <= 0, s is divided into one chunk per worker,
+// all of about the same size.
+//
+// If any call to f returns an error,
+// the context passed to the other calls is canceled,
+// no further chunks are dispatched,
+// and ForEachChunkConcurrent returns that error
+// once all calls in progress have finished.
+// If ctx is canceled before every chunk has been passed to f,
+// ForEachChunkConcurrent stops dispatching and returns ctx.Err().
+//
+// Each chunk is a subslice of s, not a copy.
+// Calls to f happen concurrently and in no particular order.
+func ForEachChunkConcurrent[T any](ctx context.Context, s []T, chunkSize, workers int, f func(context.Context, []T) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if chunkSize <= 0 {
+		chunkSize = max(1, (len(s)+workers-1)/workers)
+	}
+
+	innerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		skipped  atomic.Bool
+		ch       = make(chan []T)
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range ch {
+				if innerCtx.Err() != nil {
+					skipped.Store(true)
+					continue
+				}
+				if err := f(innerCtx, chunk); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	var interrupted bool
+
+dispatch:
+	for len(s) > 0 {
+		if innerCtx.Err() != nil {
+			interrupted = true
+			break
+		}
+		n := min(chunkSize, len(s))
+		select {
+		case ch <- s[:n]:
+			s = s[n:]
+		case <-innerCtx.Done():
+			interrupted = true
+			break dispatch
+		}
+	}
+	close(ch)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if interrupted || skipped.Load() {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// MapReduceConcurrent calls mapf on each element of s using workers goroutines,
+// and folds the mapped values into a single result with reducef,
+// starting from init.
+//
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+//
+// The elements of s are divided into one contiguous range per worker.
+// Each worker maps its range into a private buffer,
+// so the workers do not contend with one another,
+// and each buffer is folded into the result as its worker finishes.
+// All calls to reducef happen on the calling goroutine.
+//
+// Note that every mapped value is buffered before it is folded,
+// so this uses extra memory proportional to len(s).
+// Per-worker partial accumulators would avoid that,
+// but merging them would require a way to combine two R values,
+// which reducef does not provide.
+//
+// Because workers finish in no particular order,
+// the mapped values are not folded in the order of s.
+// For the result to be well defined,
+// reducef must therefore not depend on that order
+// (as with a sum, a maximum, or a set union).
+// The init value is folded in exactly once,
+// as the starting point.
+// If s is empty, the result is init.
+func MapReduceConcurrent[T, M, R any](s []T, workers int, mapf func(T) M, reducef func(R, M) R, init R) R {
+	if len(s) == 0 {
+		return init
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(s))
+
+	var (
+		ch          = make(chan []M, workers)
+		size, extra = len(s) / workers, len(s) % workers
+		rest        = s
+	)
+	for i := range workers {
+		n := size
+		if i < extra {
+			n++
+		}
+		part := rest[:n]
+		rest = rest[n:]
+		go func() {
+			mapped := make([]M, len(part))
+			for j, val := range part {
+				mapped[j] = mapf(val)
+			}
+			ch <- mapped
+		}()
+	}
+
+	result := init
+	for range workers {
+		for _, m := range <-ch {
+			result = reducef(result, m)
+		}
+	}
+	return result
+}
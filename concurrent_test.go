@@ -0,0 +1,112 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachChunkConcurrent(t *testing.T) {
+	inp := make([]int, 100)
+	for i := range inp {
+		inp[i] = i + 1
+	}
+
+	var (
+		mu     sync.Mutex
+		sum    int
+		chunks int
+	)
+	err := ForEachChunkConcurrent(context.Background(), inp, 7, 3, func(_ context.Context, chunk []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		chunks++
+		for _, n := range chunk {
+			sum += n
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 5050 {
+		t.Errorf("got sum %d, want 5050", sum)
+	}
+	if chunks != 15 {
+		t.Errorf("got %d chunks, want 15", chunks)
+	}
+
+	e := errors.New("error")
+	err = ForEachChunkConcurrent(context.Background(), inp, 0, 0, func(_ context.Context, chunk []int) error {
+		if chunk[0] == 1 {
+			return e
+		}
+		return nil
+	})
+	if !errors.Is(err, e) {
+		t.Errorf("got %v, want error %v", err, e)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = ForEachChunkConcurrent(ctx, inp, 1, 2, func(context.Context, []int) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestForEachChunkConcurrentCancelRace(t *testing.T) {
+	// Cancel the context from outside at varying times,
+	// so that it often races with the sending of the last chunks.
+	// Whenever the result is nil, every chunk must have been passed to f.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+
+	inp := make([]int, 50)
+	for iter := range 20000 {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			for range iter % 200 {
+				runtime.Gosched()
+			}
+			cancel()
+		}()
+		var processed atomic.Int64
+		err := ForEachChunkConcurrent(ctx, inp, 1, 8, func(context.Context, []int) error {
+			processed.Add(1)
+			return nil
+		})
+		cancel()
+		if err == nil && processed.Load() != int64(len(inp)) {
+			t.Fatalf("iteration %d: got nil error with only %d of %d chunks processed", iter, processed.Load(), len(inp))
+		}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			t.Fatalf("iteration %d: got %v, want %v", iter, err, context.Canceled)
+		}
+	}
+}
+
+func TestMapReduceConcurrent(t *testing.T) {
+	inp := make([]int, 1000)
+	for i := range inp {
+		inp[i] = i + 1
+	}
+	square := func(n int) int { return n * n }
+	add := func(a, b int) int { return a + b }
+
+	for _, workers := range []int{0, 1, 3, 2000} {
+		t.Run(fmt.Sprintf("workers_%d", workers), func(t *testing.T) {
+			got := MapReduceConcurrent(inp, workers, square, add, 0)
+			if want := 333833500; got != want {
+				t.Errorf("got %d, want %d", got, want)
+			}
+		})
+	}
+
+	if got := MapReduceConcurrent(nil, 4, square, add, 7); got != 7 {
+		t.Errorf("got %d for empty input, want 7", got)
+	}
+}
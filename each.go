@@ -0,0 +1,26 @@
+package slices
+
+// ForEachReverse calls f on each element of s,
+// from the last to the first,
+// passing the element's index in s and the element.
+//
+// Iterating back to front allows f to remove the element it is passed
+// (e.g. with RemoveN)
+// without disturbing the indices of the elements still to come.
+func ForEachReverse[T any](s []T, f func(idx int, elem T)) {
+	for i := len(s) - 1; i >= 0; i-- {
+		f(i, s[i])
+	}
+}
+
+// ForEachReverseUntil calls f on each element of s,
+// from the last to the first,
+// passing the element's index in s and the element,
+// until f returns true.
+func ForEachReverseUntil[T any](s []T, f func(idx int, elem T) bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(i, s[i]) {
+			return
+		}
+	}
+}
@@ -41,3 +41,24 @@ func TestEach(t *testing.T) {
 		t.Errorf("got %v, want error %v", err, e)
 	}
 }
+
+func TestForEachReverse(t *testing.T) {
+	inp := []int{2, 3, 5}
+
+	var got []int
+	ForEachReverse(inp, func(idx, val int) {
+		got = append(got, idx, val)
+	})
+	if want := []int{2, 5, 1, 3, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = nil
+	ForEachReverseUntil(inp, func(idx, val int) bool {
+		got = append(got, idx, val)
+		return val == 3
+	})
+	if want := []int{2, 5, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
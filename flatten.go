@@ -0,0 +1,77 @@
+package slices
+
+// Join concatenates the elements of s into a new slice,
+// placing the values in sep between adjacent elements
+// (but not before the first or after the last).
+// It is the slice analog of strings.Join.
+// If sep is empty, Join simply concatenates the elements of s.
+//
+// Example: Join([[a, b], [c], [d, e]], x) -> [a, b, x, c, x, d, e]
+func Join[T any](s [][]T, sep ...T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	n := len(sep) * (len(s) - 1)
+	for _, elem := range s {
+		n += len(elem)
+	}
+	result := make([]T, 0, n)
+	for i, elem := range s {
+		if i > 0 {
+			result = append(result, sep...)
+		}
+		result = append(result, elem...)
+	}
+	return result
+}
+
+// FlattenInto appends the elements of each slice in src, in order, to dst
+// and returns the result.
+// It does not overwrite the existing contents of dst.
+// Like Go's builtin append,
+// it reallocates dst only if it lacks the capacity to hold everything,
+// so reusing a buffer across calls
+// (e.g. FlattenInto(buf[:0], src))
+// avoids allocation.
+//
+// Example: FlattenInto([x], [[a, b], [c]]) -> [x, a, b, c]
+func FlattenInto[T any](dst []T, src [][]T) []T {
+	var n int
+	for _, s := range src {
+		n += len(s)
+	}
+	if cap(dst)-len(dst) < n {
+		grown := make([]T, len(dst), len(dst)+n)
+		copy(grown, dst)
+		dst = grown
+	}
+	for _, s := range src {
+		dst = append(dst, s...)
+	}
+	return dst
+}
+
+// FlattenWithGroups concatenates the slices in groups into a single new slice, flat,
+// and also returns a parallel slice, groupOf,
+// in which groupOf[i] is the index in groups of the slice that flat[i] came from.
+// Empty groups contribute nothing to either result.
+//
+// Example: FlattenWithGroups([[a, b], [], [c]]) -> [a, b, c], [0, 0, 2]
+func FlattenWithGroups[T any](groups [][]T) (flat []T, groupOf []int) {
+	var n int
+	for _, g := range groups {
+		n += len(g)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	flat = make([]T, 0, n)
+	groupOf = make([]int, 0, n)
+	for i, g := range groups {
+		flat = append(flat, g...)
+		for range g {
+			groupOf = append(groupOf, i)
+		}
+	}
+	return flat, groupOf
+}
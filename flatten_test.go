@@ -0,0 +1,84 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	cases := []struct {
+		inp  [][]int
+		sep  []int
+		want []int
+	}{{
+		inp: nil, sep: []int{0}, want: nil,
+	}, {
+		inp: [][]int{{1, 2}}, sep: []int{0}, want: []int{1, 2},
+	}, {
+		inp: [][]int{{1, 2}, {3}, {4, 5}}, sep: []int{0}, want: []int{1, 2, 0, 3, 0, 4, 5},
+	}, {
+		inp: [][]int{{1, 2}, {}, {3}}, sep: []int{0, 0}, want: []int{1, 2, 0, 0, 0, 0, 3},
+	}, {
+		inp: [][]int{{1, 2}, {3}}, sep: nil, want: []int{1, 2, 3},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := Join(tc.inp, tc.sep...)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenInto(t *testing.T) {
+	src := [][]int{{1, 2}, {}, {3}}
+
+	got := FlattenInto([]int{0}, src)
+	if want := []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	buf := make([]int, 0, 10)
+	got = FlattenInto(buf, src)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if &got[0] != &buf[:1][0] {
+		t.Error("buffer with sufficient capacity was reallocated")
+	}
+
+	if got := FlattenInto[int](nil, nil); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestFlattenWithGroups(t *testing.T) {
+	cases := []struct {
+		inp         [][]string
+		wantFlat    []string
+		wantGroupOf []int
+	}{{
+		inp: nil, wantFlat: nil, wantGroupOf: nil,
+	}, {
+		inp: [][]string{{}, {}}, wantFlat: nil, wantGroupOf: nil,
+	}, {
+		inp:         [][]string{{"a", "b"}, {}, {"c"}},
+		wantFlat:    []string{"a", "b", "c"},
+		wantGroupOf: []int{0, 0, 2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			flat, groupOf := FlattenWithGroups(tc.inp)
+			if !reflect.DeepEqual(flat, tc.wantFlat) {
+				t.Errorf("got flat %v, want %v", flat, tc.wantFlat)
+			}
+			if !reflect.DeepEqual(groupOf, tc.wantGroupOf) {
+				t.Errorf("got groupOf %v, want %v", groupOf, tc.wantGroupOf)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package slices
+
+// KV is a key-value pair.
+type KV[K, V any] struct {
+	Key K
+	Val V
+}
+
+// GroupReduceToSlice partitions the elements of s into groups by the value that key derives from each element,
+// and folds the elements of each group into an aggregate value by calling combine,
+// starting from the value init.
+// The result is a slice of key-aggregate pairs,
+// one per distinct key,
+// in the order in which each key first appears in s.
+//
+// Unlike a map, the result has a deterministic order.
+func GroupReduceToSlice[T any, K comparable, V any](s []T, key func(T) K, init V, combine func(V, T) V) []KV[K, V] {
+	var (
+		result []KV[K, V]
+		index  = make(map[K]int)
+	)
+	for _, val := range s {
+		k := key(val)
+		i, ok := index[k]
+		if !ok {
+			i = len(result)
+			index[k] = i
+			result = append(result, KV[K, V]{Key: k, Val: init})
+		}
+		result[i].Val = combine(result[i].Val, val)
+	}
+	return result
+}
+
+// OrderedFrequencies counts the occurrences of each distinct value in s.
+// The result is a slice of value-count pairs,
+// in the order in which each value first appears in s.
+//
+// Example: OrderedFrequencies([b, a, b, c, a, b]) -> [{b, 3}, {a, 2}, {c, 1}]
+func OrderedFrequencies[T comparable](s []T) []KV[T, int] {
+	return GroupReduceToSlice(s, func(val T) T { return val }, 0, func(n int, _ T) int { return n + 1 })
+}
@@ -24,3 +24,34 @@ func TestGroup(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestGroupReduceToSlice(t *testing.T) {
+	inp := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+	want := []KV[byte, int]{
+		{Key: 'b', Val: 15},
+		{Key: 'a', Val: 12},
+		{Key: 'c', Val: 6},
+	}
+	got := GroupReduceToSlice(inp, func(s string) byte { return s[0] }, 0, func(total int, s string) int {
+		return total + len(s)
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := GroupReduceToSlice(nil, func(s string) byte { return s[0] }, 0, func(int, string) int { return 0 }); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestOrderedFrequencies(t *testing.T) {
+	got := OrderedFrequencies([]string{"b", "a", "b", "c", "a", "b"})
+	want := []KV[string, int]{
+		{Key: "b", Val: 3},
+		{Key: "a", Val: 2},
+		{Key: "c", Val: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
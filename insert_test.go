@@ -46,3 +46,13 @@ func TestInsert(t *testing.T) {
 		})
 	}
 }
+
+func TestPaste(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	s, clip := Cut(s, 1, 3)
+	got := Paste(s, -1, clip)
+	want := []int{1, 4, 2, 3, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,25 @@
+package slices
+
+import "fmt"
+
+// RequireLen returns an error if the length of s is outside the range [minLen, maxLen].
+// A negative maxLen means there is no upper bound.
+// The error message reports the actual length and the allowed range.
+func RequireLen[T any](s []T, minLen, maxLen int) error {
+	switch {
+	case maxLen < 0 && len(s) < minLen:
+		return fmt.Errorf("length %d is less than minimum %d", len(s), minLen)
+	case maxLen >= 0 && (len(s) < minLen || len(s) > maxLen):
+		return fmt.Errorf("length %d is outside allowed range [%d, %d]", len(s), minLen, maxLen)
+	}
+	return nil
+}
+
+// MustLen is like RequireLen but panics instead of returning an error.
+// Otherwise it returns s.
+func MustLen[T any](s []T, minLen, maxLen int) []T {
+	if err := RequireLen(s, minLen, maxLen); err != nil {
+		panic(err)
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package slices
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRequireLen(t *testing.T) {
+	cases := []struct {
+		inp            []int
+		minLen, maxLen int
+		wantErr        string
+	}{{
+		inp: nil, minLen: 0, maxLen: 0,
+	}, {
+		inp: []int{1, 2}, minLen: 1, maxLen: 3,
+	}, {
+		inp: []int{1, 2}, minLen: 3, maxLen: 5, wantErr: "length 2 is outside allowed range [3, 5]",
+	}, {
+		inp: []int{1, 2}, minLen: 0, maxLen: 1, wantErr: "length 2 is outside allowed range [0, 1]",
+	}, {
+		inp: []int{1, 2}, minLen: 2, maxLen: -1,
+	}, {
+		inp: []int{1, 2}, minLen: 3, maxLen: -1, wantErr: "length 2 is less than minimum 3",
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			err := RequireLen(tc.inp, tc.minLen, tc.maxLen)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v, want none", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("got error %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMustLen(t *testing.T) {
+	s := MustLen([]int{1}, 1, 1)
+	if len(s) != 1 {
+		t.Errorf("got %v, want [1]", s)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("got no panic, want one")
+		}
+	}()
+	MustLen([]int{1}, 2, -1)
+}
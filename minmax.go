@@ -0,0 +1,93 @@
+package slices
+
+import "cmp"
+
+// ArgMinRange returns the index of the smallest element of s
+// beginning at position from and ending before position to.
+// The index is relative to s as a whole, not to the range.
+// Ties go to the earliest index.
+// If the range is empty the result is -1.
+//
+// If from < 0 it counts from the end of s.
+// If to <= 0 it counts from the end of s.
+func ArgMinRange[T cmp.Ordered](s []T, from, to int) int {
+	return argExtremeRange(s, from, to, cmp.Less[T])
+}
+
+// ArgMaxRange returns the index of the largest element of s
+// beginning at position from and ending before position to.
+// The index is relative to s as a whole, not to the range.
+// Ties go to the earliest index.
+// If the range is empty the result is -1.
+//
+// If from < 0 it counts from the end of s.
+// If to <= 0 it counts from the end of s.
+func ArgMaxRange[T cmp.Ordered](s []T, from, to int) int {
+	return argExtremeRange(s, from, to, func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// argExtremeRange returns the index of the first element in the given range of s
+// for which no other element in the range is better.
+func argExtremeRange[T any](s []T, from, to int, better func(a, b T) bool) int {
+	if from < 0 {
+		from += len(s)
+	}
+	if to < 0 {
+		to += len(s)
+	} else if to == 0 {
+		to = len(s)
+	}
+	if from >= to {
+		return -1
+	}
+
+	result := from
+	for i := from + 1; i < to; i++ {
+		if better(s[i], s[result]) {
+			result = i
+		}
+	}
+	return result
+}
+
+// LocalMaxima returns, in ascending order,
+// the indices of the elements of s that are strictly greater than their neighbors.
+// The first and last elements have only one neighbor each,
+// and qualify if they are strictly greater than it.
+//
+// Because the comparison is strict,
+// no element of a plateau (a run of equal elements) is a local maximum,
+// even if the plateau as a whole is a peak.
+// If s has fewer than two elements, the result is empty.
+//
+// Example: LocalMaxima([3, 1, 4, 4, 2, 5]) -> [0, 5]
+func LocalMaxima[T cmp.Ordered](s []T) []int {
+	return localExtrema(s, func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// LocalMinima returns, in ascending order,
+// the indices of the elements of s that are strictly less than their neighbors.
+// It treats endpoints and plateaus the same way as LocalMaxima.
+//
+// Example: LocalMinima([3, 1, 4, 4, 2, 5]) -> [1, 4]
+func LocalMinima[T cmp.Ordered](s []T) []int {
+	return localExtrema(s, cmp.Less[T])
+}
+
+// localExtrema returns the indices of the elements of s that are better than each of their neighbors.
+func localExtrema[T any](s []T, better func(a, b T) bool) []int {
+	if len(s) < 2 {
+		return nil
+	}
+	var result []int
+	for i, val := range s {
+		if i > 0 && !better(val, s[i-1]) {
+			continue
+		}
+		if i+1 < len(s) && !better(val, s[i+1]) {
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
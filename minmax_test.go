@@ -0,0 +1,73 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestArgMinMaxRange(t *testing.T) {
+	inp := []int{5, 1, 4, 9, 1, 9, 2}
+
+	cases := []struct {
+		from, to         int
+		wantMin, wantMax int
+	}{{
+		from: 0, to: 0, wantMin: 1, wantMax: 3,
+	}, {
+		from: 2, to: 4, wantMin: 2, wantMax: 3,
+	}, {
+		from: -3, to: 0, wantMin: 4, wantMax: 5,
+	}, {
+		from: -3, to: -1, wantMin: 4, wantMax: 5,
+	}, {
+		from: 3, to: 3, wantMin: -1, wantMax: -1,
+	}, {
+		from: 6, to: 7, wantMin: 6, wantMax: 6,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := ArgMinRange(inp, tc.from, tc.to); got != tc.wantMin {
+				t.Errorf("ArgMinRange: got %d, want %d", got, tc.wantMin)
+			}
+			if got := ArgMaxRange(inp, tc.from, tc.to); got != tc.wantMax {
+				t.Errorf("ArgMaxRange: got %d, want %d", got, tc.wantMax)
+			}
+		})
+	}
+
+	if got := ArgMinRange([]int(nil), 0, 0); got != -1 {
+		t.Errorf("ArgMinRange of empty slice: got %d, want -1", got)
+	}
+}
+
+func TestLocalExtrema(t *testing.T) {
+	cases := []struct {
+		inp              []int
+		wantMax, wantMin []int
+	}{{
+		inp: nil, wantMax: nil, wantMin: nil,
+	}, {
+		inp: []int{1}, wantMax: nil, wantMin: nil,
+	}, {
+		inp: []int{1, 2}, wantMax: []int{1}, wantMin: []int{0},
+	}, {
+		inp: []int{3, 1, 4, 4, 2, 5}, wantMax: []int{0, 5}, wantMin: []int{1, 4},
+	}, {
+		inp: []int{1, 3, 2, 3, 1}, wantMax: []int{1, 3}, wantMin: []int{0, 2, 4},
+	}, {
+		inp: []int{2, 2, 2}, wantMax: nil, wantMin: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := LocalMaxima(tc.inp); !reflect.DeepEqual(got, tc.wantMax) {
+				t.Errorf("LocalMaxima: got %v, want %v", got, tc.wantMax)
+			}
+			if got := LocalMinima(tc.inp); !reflect.DeepEqual(got, tc.wantMin) {
+				t.Errorf("LocalMinima: got %v, want %v", got, tc.wantMin)
+			}
+		})
+	}
+}
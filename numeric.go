@@ -0,0 +1,284 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Integer is a constraint satisfied by Go's integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Number is a constraint satisfied by Go's integer and floating-point types.
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// ClosestPair finds the two adjacent elements of s with the smallest difference between them.
+// It returns their indices i and j (where j == i+1) and the difference s[j]-s[i].
+// Ties go to the earliest pair.
+//
+// The input slice must be sorted in ascending order;
+// otherwise the result is not meaningful.
+//
+// If s has fewer than two elements there is no pair,
+// and the result is -1, -1, and 0.
+func ClosestPair[T Number](s []T) (i, j int, diff T) {
+	if len(s) < 2 {
+		return -1, -1, 0
+	}
+	i, diff = 0, s[1]-s[0]
+	for k := 1; k+1 < len(s); k++ {
+		if d := s[k+1] - s[k]; d < diff {
+			i, diff = k, d
+		}
+	}
+	return i, i + 1, diff
+}
+
+// Downsample divides s into consecutive buckets of factor elements
+// and returns the average of each bucket.
+// The result has len(s)/factor elements, rounded up;
+// if factor does not divide len(s),
+// the last bucket has fewer elements and its average is over just those.
+//
+// It is an error for factor to be less than 1.
+//
+// Example: Downsample([1, 2, 3, 4, 5], 2) -> [1.5, 3.5, 5]
+func Downsample[T Number](s []T, factor int) ([]float64, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("downsampling factor %d is not positive", factor)
+	}
+	if len(s) == 0 {
+		return nil, nil
+	}
+	result := make([]float64, 0, (len(s)+factor-1)/factor)
+	for len(s) > 0 {
+		n := min(factor, len(s))
+		result = append(result, mean(s[:n]))
+		s = s[n:]
+	}
+	return result, nil
+}
+
+// mean returns the arithmetic mean of the elements of s,
+// which must not be empty.
+func mean[T Number](s []T) float64 {
+	var sum float64
+	for _, val := range s {
+		sum += float64(val)
+	}
+	return sum / float64(len(s))
+}
+
+// UpsampleRepeat returns a new slice in which each element of s is repeated factor times,
+// so the result has factor*len(s) elements.
+//
+// It is an error for factor to be less than 1.
+//
+// Example: UpsampleRepeat([a, b], 3) -> [a, a, a, b, b, b]
+func UpsampleRepeat[T any](s []T, factor int) ([]T, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("upsampling factor %d is not positive", factor)
+	}
+	if len(s) == 0 {
+		return nil, nil
+	}
+	result := make([]T, 0, factor*len(s))
+	for _, val := range s {
+		for range factor {
+			result = append(result, val)
+		}
+	}
+	return result, nil
+}
+
+// UpsampleLinear returns a new slice in which factor-1 evenly spaced points
+// are linearly interpolated between each adjacent pair of elements of s.
+//
+// The result begins with s[0] and ends with s[len(s)-1],
+// with no points added beyond those endpoints,
+// so it has (len(s)-1)*factor+1 elements.
+// (If s is empty, so is the result.)
+//
+// It is an error for factor to be less than 1.
+//
+// Example: UpsampleLinear([0, 1, 3], 2) -> [0, 0.5, 1, 2, 3]
+func UpsampleLinear[T Number](s []T, factor int) ([]float64, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("upsampling factor %d is not positive", factor)
+	}
+	if len(s) == 0 {
+		return nil, nil
+	}
+	result := make([]float64, 0, (len(s)-1)*factor+1)
+	for i := 0; i+1 < len(s); i++ {
+		a, b := float64(s[i]), float64(s[i+1])
+		for k := range factor {
+			result = append(result, a+(b-a)*float64(k)/float64(factor))
+		}
+	}
+	result = append(result, float64(s[len(s)-1]))
+	return result, nil
+}
+
+// MissingInts returns, in ascending order,
+// the integers between the smallest and largest elements of s
+// that do not appear in s.
+// If s has fewer than two elements, the result is empty.
+//
+// The input slice must be sorted in ascending order;
+// otherwise the result is not meaningful.
+// Duplicate elements are fine.
+//
+// Example: MissingInts([1, 2, 2, 5, 7]) -> [3, 4, 6]
+func MissingInts[T Integer](s []T) []T {
+	var result []T
+	for i := 1; i < len(s); i++ {
+		if s[i] <= s[i-1] {
+			continue
+		}
+		for v := s[i-1] + 1; v < s[i]; v++ {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// WeightedMovingAverage returns the weighted average of each window of len(weights) consecutive elements of s,
+// i.e. the sum of each element times its weight,
+// divided by the sum of the weights.
+// Within each window,
+// weights[0] applies to the oldest (leftmost) element
+// and weights[len(weights)-1] to the newest.
+//
+// The i'th result is for the window ending at s[i+len(weights)-1].
+// Positions with fewer than len(weights) elements before them are omitted,
+// so the result has len(s)-len(weights)+1 elements,
+// or none if s is shorter than weights.
+//
+// It is an error for weights to be empty or for its elements to sum to zero.
+//
+// Example: WeightedMovingAverage([1, 2, 3, 4], [1, 3]) -> [1.75, 2.75, 3.75]
+func WeightedMovingAverage[T Number](s []T, weights []T) ([]float64, error) {
+	if len(weights) == 0 {
+		return nil, errors.New("no weights")
+	}
+	var total float64
+	for _, w := range weights {
+		total += float64(w)
+	}
+	if total == 0 {
+		return nil, errors.New("weights sum to zero")
+	}
+	if len(s) < len(weights) {
+		return nil, nil
+	}
+
+	result := make([]float64, 0, len(s)-len(weights)+1)
+	for i := 0; i+len(weights) <= len(s); i++ {
+		var sum float64
+		for j, w := range weights {
+			sum += float64(s[i+j]) * float64(w)
+		}
+		result = append(result, sum/total)
+	}
+	return result, nil
+}
+
+// MaxSubarraySum finds the window of length consecutive elements of s with the largest sum.
+// It returns the index at which the window starts, and the sum.
+// Ties go to the earliest window.
+//
+// The sum is maintained as the window slides,
+// adding the element that enters and subtracting the one that leaves,
+// so this takes time proportional to len(s).
+//
+// If length is greater than len(s), or less than 1,
+// there is no window and the result is -1 and 0.
+//
+// Example: MaxSubarraySum([1, 5, 2, 6, 1], 2) -> 2, 8
+func MaxSubarraySum[T Number](s []T, length int) (start int, sum T) {
+	if length < 1 || length > len(s) {
+		return -1, 0
+	}
+	for _, val := range s[:length] {
+		sum += val
+	}
+	cur := sum
+	for i := length; i < len(s); i++ {
+		cur += s[i] - s[i-length]
+		if cur > sum {
+			start, sum = i-length+1, cur
+		}
+	}
+	return start, sum
+}
+
+// MaxSubarray finds the contiguous, non-empty subslice of s with the largest sum,
+// using Kadane's algorithm,
+// which takes time proportional to len(s).
+// It returns the subslice's bounds as s[start:end], and the sum.
+//
+// If every element of s is negative,
+// the result is the single largest element.
+// Among subslices with the same sum,
+// the one ending earliest is chosen,
+// and among those, the shortest.
+//
+// If s is empty, the result is 0, 0, and 0.
+//
+// Example: MaxSubarray([-2, 1, -3, 4, -1, 2, 1, -5, 4]) -> 3, 7, 6
+func MaxSubarray[T Number](s []T) (start, end int, sum T) {
+	var (
+		cur      T
+		curStart int
+	)
+	for i, val := range s {
+		if i == 0 || cur <= 0 {
+			cur, curStart = val, i
+		} else {
+			cur += val
+		}
+		if i == 0 || cur > sum {
+			start, end, sum = curStart, i+1, cur
+		}
+	}
+	return start, end, sum
+}
+
+// LongestConsecutive returns the length of the longest run of consecutive integer values present in s,
+// regardless of the order in which they appear or of duplicates.
+// If s is empty the result is 0.
+//
+// It uses a set of the values in s,
+// taking time proportional to len(s).
+//
+// Example: LongestConsecutive([100, 4, 200, 1, 3, 2, 2]) -> 4 (for 1, 2, 3, 4)
+func LongestConsecutive[T Integer](s []T) int {
+	set := make(map[T]struct{}, len(s))
+	for _, val := range s {
+		set[val] = struct{}{}
+	}
+
+	var result int
+	for val := range set {
+		if prev := val - 1; prev < val {
+			if _, ok := set[prev]; ok {
+				// Not the start of a run.
+				continue
+			}
+		}
+		n := 1
+		for cur := val; cur+1 > cur; cur++ {
+			if _, ok := set[cur+1]; !ok {
+				break
+			}
+			n++
+		}
+		result = max(result, n)
+	}
+	return result
+}
@@ -0,0 +1,288 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestClosestPair(t *testing.T) {
+	cases := []struct {
+		inp          []float64
+		wantI, wantJ int
+		wantDiff     float64
+	}{{
+		inp: nil, wantI: -1, wantJ: -1, wantDiff: 0,
+	}, {
+		inp: []float64{1}, wantI: -1, wantJ: -1, wantDiff: 0,
+	}, {
+		inp: []float64{1, 3}, wantI: 0, wantJ: 1, wantDiff: 2,
+	}, {
+		inp: []float64{1, 4, 5, 9, 9.5}, wantI: 3, wantJ: 4, wantDiff: 0.5,
+	}, {
+		inp: []float64{1, 2, 3, 4}, wantI: 0, wantJ: 1, wantDiff: 1,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			gotI, gotJ, gotDiff := ClosestPair(tc.inp)
+			if gotI != tc.wantI || gotJ != tc.wantJ || gotDiff != tc.wantDiff {
+				t.Errorf("got %d, %d, %v; want %d, %d, %v", gotI, gotJ, gotDiff, tc.wantI, tc.wantJ, tc.wantDiff)
+			}
+		})
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	cases := []struct {
+		inp     []int
+		factor  int
+		want    []float64
+		wantErr bool
+	}{{
+		inp: nil, factor: 2, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, factor: 2, want: []float64{1.5, 3.5, 5},
+	}, {
+		inp: []int{1, 2, 3, 4, 5, 6}, factor: 3, want: []float64{2, 5},
+	}, {
+		inp: []int{1, 2}, factor: 1, want: []float64{1, 2},
+	}, {
+		inp: []int{1, 2}, factor: 0, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := Downsample(tc.inp, tc.factor)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUpsampleRepeat(t *testing.T) {
+	got, err := UpsampleRepeat([]string{"a", "b"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "a", "a", "b", "b", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := UpsampleRepeat([]string{"a"}, 0); err == nil {
+		t.Error("got no error for factor 0, want one")
+	}
+}
+
+func TestUpsampleLinear(t *testing.T) {
+	cases := []struct {
+		inp    []int
+		factor int
+		want   []float64
+	}{{
+		inp: nil, factor: 2, want: nil,
+	}, {
+		inp: []int{7}, factor: 2, want: []float64{7},
+	}, {
+		inp: []int{0, 1, 3}, factor: 2, want: []float64{0, 0.5, 1, 2, 3},
+	}, {
+		inp: []int{4, 0}, factor: 4, want: []float64{4, 3, 2, 1, 0},
+	}, {
+		inp: []int{1, 2}, factor: 1, want: []float64{1, 2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := UpsampleLinear(tc.inp, tc.factor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := UpsampleLinear([]int{1, 2}, -1); err == nil {
+		t.Error("got no error for factor -1, want one")
+	}
+}
+
+func TestMissingInts(t *testing.T) {
+	cases := []struct {
+		inp, want []int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []int{4}, want: nil,
+	}, {
+		inp: []int{1, 2, 2, 5, 7}, want: []int{3, 4, 6},
+	}, {
+		inp: []int{-2, 1}, want: []int{-1, 0},
+	}, {
+		inp: []int{1, 2, 3}, want: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := MissingInts(tc.inp)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// No overflow at the top of the type's range.
+	for _, tc := range []struct {
+		inp, want []uint8
+	}{{
+		inp: []uint8{253, 255}, want: []uint8{254},
+	}, {
+		inp: []uint8{255, 255}, want: nil,
+	}, {
+		inp: []uint8{254, 255, 255}, want: nil,
+	}} {
+		if got := MissingInts(tc.inp); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("MissingInts(%v): got %v, want %v", tc.inp, got, tc.want)
+		}
+	}
+	if got := MissingInts([]int8{126, 127, 127}); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestWeightedMovingAverage(t *testing.T) {
+	cases := []struct {
+		inp, weights []int
+		want         []float64
+		wantErr      bool
+	}{{
+		inp: nil, weights: []int{1}, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4}, weights: []int{1, 3}, want: []float64{1.75, 2.75, 3.75},
+	}, {
+		inp: []int{1, 2, 3, 4}, weights: []int{1, 1, 1, 1}, want: []float64{2.5},
+	}, {
+		inp: []int{1, 2}, weights: []int{1, 1, 1}, want: nil,
+	}, {
+		inp: []int{1, 2}, weights: nil, wantErr: true,
+	}, {
+		inp: []int{1, 2}, weights: []int{1, -1}, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := WeightedMovingAverage(tc.inp, tc.weights)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMaxSubarraySum(t *testing.T) {
+	cases := []struct {
+		inp                []int
+		length             int
+		wantStart, wantSum int
+	}{{
+		inp: nil, length: 1, wantStart: -1, wantSum: 0,
+	}, {
+		inp: []int{1, 2}, length: 3, wantStart: -1, wantSum: 0,
+	}, {
+		inp: []int{1, 5, 2, 6, 1}, length: 2, wantStart: 2, wantSum: 8,
+	}, {
+		inp: []int{4, 1, 1, 4}, length: 2, wantStart: 0, wantSum: 5,
+	}, {
+		inp: []int{-3, -1, -2}, length: 1, wantStart: 1, wantSum: -1,
+	}, {
+		inp: []int{1, 2, 3}, length: 3, wantStart: 0, wantSum: 6,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			start, sum := MaxSubarraySum(tc.inp, tc.length)
+			if start != tc.wantStart || sum != tc.wantSum {
+				t.Errorf("got %d, %d; want %d, %d", start, sum, tc.wantStart, tc.wantSum)
+			}
+		})
+	}
+}
+
+func TestMaxSubarray(t *testing.T) {
+	cases := []struct {
+		inp                         []int
+		wantStart, wantEnd, wantSum int
+	}{{
+		inp: nil, wantStart: 0, wantEnd: 0, wantSum: 0,
+	}, {
+		inp: []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, wantStart: 3, wantEnd: 7, wantSum: 6,
+	}, {
+		inp: []int{-3, -1, -2}, wantStart: 1, wantEnd: 2, wantSum: -1,
+	}, {
+		inp: []int{1, 2, 3}, wantStart: 0, wantEnd: 3, wantSum: 6,
+	}, {
+		inp: []int{2, -2, 2}, wantStart: 0, wantEnd: 1, wantSum: 2,
+	}, {
+		inp: []int{0, 0, 3}, wantStart: 2, wantEnd: 3, wantSum: 3,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			start, end, sum := MaxSubarray(tc.inp)
+			if start != tc.wantStart || end != tc.wantEnd || sum != tc.wantSum {
+				t.Errorf("got %d, %d, %d; want %d, %d, %d", start, end, sum, tc.wantStart, tc.wantEnd, tc.wantSum)
+			}
+		})
+	}
+}
+
+func TestLongestConsecutive(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		want int
+	}{{
+		inp: nil, want: 0,
+	}, {
+		inp: []int{5}, want: 1,
+	}, {
+		inp: []int{100, 4, 200, 1, 3, 2, 2}, want: 4,
+	}, {
+		inp: []int{1, 3, 5}, want: 1,
+	}, {
+		inp: []int{-1, 1, 0, 7, 8}, want: 3,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := LongestConsecutive(tc.inp); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+
+	// No wraparound at the ends of the type's range.
+	if got := LongestConsecutive([]uint8{0, 1, 254, 255}); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+}
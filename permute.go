@@ -0,0 +1,82 @@
+package slices
+
+import "fmt"
+
+// ApplyPermutation returns a new slice containing the elements of s reordered according to perm,
+// so that result[i] is s[perm[i]].
+// It is the natural partner of Argsort:
+// ApplyPermutation(s, Argsort(s)) is s sorted.
+//
+// It is an error for perm not to be a permutation of the indices of s,
+// i.e. to contain anything but each of 0 through len(s)-1 exactly once.
+//
+// The input slice is not modified.
+// See ApplyPermutationInPlace for a variant that does not allocate a new slice of T.
+func ApplyPermutation[T any](s []T, perm []int) ([]T, error) {
+	if err := checkPermutation(perm, len(s)); err != nil {
+		return nil, err
+	}
+	if len(s) == 0 {
+		return nil, nil
+	}
+	result := make([]T, len(s))
+	for i, j := range perm {
+		result[i] = s[j]
+	}
+	return result, nil
+}
+
+// ApplyPermutationInPlace reorders the elements of s according to perm,
+// so that afterwards s[i] holds what was previously at s[perm[i]].
+//
+// It works by following the cycles of perm,
+// moving each element once,
+// so it needs only a bit of bookkeeping space rather than a copy of s.
+//
+// It is an error for perm not to be a permutation of the indices of s,
+// i.e. to contain anything but each of 0 through len(s)-1 exactly once.
+// In that case s is not modified.
+//
+// The input slice is modified.
+func ApplyPermutationInPlace[T any](s []T, perm []int) error {
+	if err := checkPermutation(perm, len(s)); err != nil {
+		return err
+	}
+	done := make([]bool, len(s))
+	for i := range s {
+		if done[i] {
+			continue
+		}
+		tmp := s[i]
+		j := i
+		for {
+			done[j] = true
+			k := perm[j]
+			if k == i {
+				s[j] = tmp
+				break
+			}
+			s[j] = s[k]
+			j = k
+		}
+	}
+	return nil
+}
+
+// checkPermutation returns an error unless perm contains each of 0 through n-1 exactly once.
+func checkPermutation(perm []int, n int) error {
+	if len(perm) != n {
+		return fmt.Errorf("permutation has length %d, want %d", len(perm), n)
+	}
+	seen := make([]bool, n)
+	for i, j := range perm {
+		if j < 0 || j >= n {
+			return fmt.Errorf("permutation index %d at position %d is out of range", j, i)
+		}
+		if seen[j] {
+			return fmt.Errorf("permutation index %d at position %d is a duplicate", j, i)
+		}
+		seen[j] = true
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestApplyPermutation(t *testing.T) {
+	cases := []struct {
+		inp     string
+		perm    []int
+		want    string
+		wantErr bool
+	}{{
+		inp: "", perm: nil, want: "",
+	}, {
+		inp: "abc", perm: []int{0, 1, 2}, want: "abc",
+	}, {
+		inp: "abcd", perm: []int{2, 0, 3, 1}, want: "cadb",
+	}, {
+		inp: "abcde", perm: []int{1, 0, 4, 2, 3}, want: "baecd",
+	}, {
+		inp: "abc", perm: []int{0, 1}, wantErr: true,
+	}, {
+		inp: "abc", perm: []int{0, 1, 3}, wantErr: true,
+	}, {
+		inp: "abc", perm: []int{0, 1, 1}, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := ApplyPermutation([]byte(tc.inp), tc.perm)
+			if tc.wantErr {
+				if err == nil {
+					t.Error("got no error, want one")
+				}
+			} else if err != nil {
+				t.Error(err)
+			} else if string(got) != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+
+			s := []byte(tc.inp)
+			err = ApplyPermutationInPlace(s, tc.perm)
+			if tc.wantErr {
+				if err == nil {
+					t.Error("in place: got no error, want one")
+				}
+				if string(s) != tc.inp {
+					t.Errorf("in place: input modified to %q despite error", s)
+				}
+			} else if err != nil {
+				t.Error(err)
+			} else if string(s) != tc.want {
+				t.Errorf("in place: got %q, want %q", s, tc.want)
+			}
+		})
+	}
+}
+
+func TestApplyArgsort(t *testing.T) {
+	inp := []int{30, 10, 20}
+	got, err := ApplyPermutation(inp, Argsort(inp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{10, 20, 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
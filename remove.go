@@ -0,0 +1,116 @@
+package slices
+
+// RemoveFirst removes the first element of s that equals v.
+// It returns the result and whether an element was removed.
+// Later elements are shifted down to fill the gap,
+// and the vacated position at the end of s is set to the zero value.
+//
+// The input slice is modified.
+//
+// Example: RemoveFirst([a, b, a], a) -> [b, a], true
+func RemoveFirst[T comparable](s []T, v T) ([]T, bool) {
+	return RemoveFirstFunc(s, func(val T) bool { return val == v })
+}
+
+// RemoveFirstFunc removes the first element of s for which pred returns true.
+// It returns the result and whether an element was removed.
+// Later elements are shifted down to fill the gap,
+// and the vacated position at the end of s is set to the zero value.
+//
+// The input slice is modified.
+func RemoveFirstFunc[T any](s []T, pred func(T) bool) ([]T, bool) {
+	for i, val := range s {
+		if pred(val) {
+			return removeNZero(s, i, 1), true
+		}
+	}
+	return s, false
+}
+
+// removeNZero is like removeN
+// but also sets the vacated positions at the end of s to the zero value,
+// so the underlying array does not retain references to them.
+func removeNZero[T any](s []T, idx, n int) []T {
+	result := removeN(s, idx, n)
+	clear(s[len(result):])
+	return result
+}
+
+// ShiftOut removes n elements from one end of s.
+// It returns the shortened slice
+// and a newly allocated slice holding the removed elements in their original order.
+//
+// If n >= 0, the first n elements are removed
+// and the remaining elements are shifted down to the start of s.
+// If n < 0, the last -n elements are removed.
+// Either way,
+// the vacated positions at the end of s are set to the zero value.
+//
+// The input slice is modified.
+//
+// Example: ShiftOut([a, b, c, d], 1) -> [b, c, d], [a]
+//
+// Example: ShiftOut([a, b, c, d], -1) -> [a, b, c], [d]
+func ShiftOut[T any](s []T, n int) (result []T, displaced []T) {
+	idx := 0
+	if n < 0 {
+		n = -n
+		idx = len(s) - n
+	}
+	displaced = make([]T, n)
+	copy(displaced, s[idx:idx+n])
+	return removeNZero(s, idx, n), displaced
+}
+
+// Cut removes items from s beginning at position from and ending before position to.
+// It returns the shortened slice
+// and a newly allocated slice holding the removed items.
+// The vacated positions at the end of s are set to the zero value.
+//
+// If from < 0 it counts from the end of s.
+// If to <= 0 it counts from the end of s.
+//
+// The input slice is modified.
+//
+// Example: Cut([a, b, c, d], 1, 3) -> [a, d], [b, c]
+func Cut[T any](s []T, from, to int) (remaining []T, removed []T) {
+	if from < 0 {
+		from += len(s)
+	}
+	if to < 0 {
+		to += len(s)
+	} else if to == 0 {
+		to = len(s)
+	}
+	removed = make([]T, to-from)
+	copy(removed, s[from:to])
+	return removeNZero(s, from, to-from), removed
+}
+
+// SpliceSlice replaces the n values of s beginning at position idx with the contents of clip.
+// It returns the result
+// and a newly allocated slice holding the replaced values.
+// After the splice, the first value from clip has position idx.
+// If the result is shorter than s,
+// the vacated positions at the end of s are set to the zero value.
+//
+// This combines Cut and Paste.
+// The clip slice must not share an underlying array with s.
+//
+// If idx < 0, it counts from the end of s.
+//
+// The input slice is modified.
+//
+// Example: SpliceSlice([a, b, c, d], 1, 2, [x]) -> [a, x, d], [b, c]
+func SpliceSlice[T any](s []T, idx, n int, clip []T) (result, removed []T) {
+	if idx < 0 {
+		idx += len(s)
+	}
+	removed = make([]T, n)
+	copy(removed, s[idx:idx+n])
+	result = replaceN(s, idx, n, clip...)
+	if len(result) < len(s) {
+		clear(s[len(result):])
+	}
+	return result, removed
+}
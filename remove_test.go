@@ -89,3 +89,142 @@ func TestRemoveTo(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveFirst(t *testing.T) {
+	cases := []struct {
+		inp      []int
+		v        int
+		want     []int
+		wantOK   bool
+		wantTail []int
+	}{{
+		inp: nil, v: 1, want: nil, wantOK: false,
+	}, {
+		inp: []int{1, 2, 1}, v: 1, want: []int{2, 1}, wantOK: true, wantTail: []int{0},
+	}, {
+		inp: []int{1, 2, 3}, v: 3, want: []int{1, 2}, wantOK: true, wantTail: []int{0},
+	}, {
+		inp: []int{1, 2, 3}, v: 4, want: []int{1, 2, 3}, wantOK: false, wantTail: []int{},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, ok := RemoveFirst(tc.inp, tc.v)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if ok != tc.wantOK {
+				t.Errorf("got ok %v, want %v", ok, tc.wantOK)
+			}
+			if tail := tc.inp[len(got):]; tc.inp != nil && !reflect.DeepEqual(tail, tc.wantTail) {
+				t.Errorf("got tail %v, want %v", tail, tc.wantTail)
+			}
+		})
+	}
+}
+
+func TestShiftOut(t *testing.T) {
+	cases := []struct {
+		inp                 []int
+		n                   int
+		want, wantDisplaced []int
+	}{{
+		inp: nil, n: 0, want: nil, wantDisplaced: []int{},
+	}, {
+		inp: []int{1, 2, 3, 4}, n: 1, want: []int{2, 3, 4}, wantDisplaced: []int{1},
+	}, {
+		inp: []int{1, 2, 3, 4}, n: 3, want: []int{4}, wantDisplaced: []int{1, 2, 3},
+	}, {
+		inp: []int{1, 2, 3, 4}, n: -1, want: []int{1, 2, 3}, wantDisplaced: []int{4},
+	}, {
+		inp: []int{1, 2, 3, 4}, n: -4, want: []int{}, wantDisplaced: []int{1, 2, 3, 4},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, displaced := ShiftOut(tc.inp, tc.n)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if !reflect.DeepEqual(displaced, tc.wantDisplaced) {
+				t.Errorf("got displaced %v, want %v", displaced, tc.wantDisplaced)
+			}
+			for _, val := range tc.inp[len(got):] {
+				if val != 0 {
+					t.Errorf("got nonzero tail %v", tc.inp[len(got):])
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCut(t *testing.T) {
+	cases := []struct {
+		inp               []int
+		from, to          int
+		want, wantRemoved []int
+	}{{
+		inp: []int{4, 5, 6, 7}, from: 1, to: 3, want: []int{4, 7}, wantRemoved: []int{5, 6},
+	}, {
+		inp: []int{4, 5, 6, 7}, from: -2, to: 0, want: []int{4, 5}, wantRemoved: []int{6, 7},
+	}, {
+		inp: []int{4, 5, 6, 7}, from: -3, to: -1, want: []int{4, 7}, wantRemoved: []int{5, 6},
+	}, {
+		inp: []int{4, 5, 6, 7}, from: 2, to: 2, want: []int{4, 5, 6, 7}, wantRemoved: []int{},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, removed := Cut(tc.inp, tc.from, tc.to)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if !reflect.DeepEqual(removed, tc.wantRemoved) {
+				t.Errorf("got removed %v, want %v", removed, tc.wantRemoved)
+			}
+			for _, val := range tc.inp[len(got):] {
+				if val != 0 {
+					t.Errorf("got nonzero tail %v", tc.inp[len(got):])
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSpliceSlice(t *testing.T) {
+	cases := []struct {
+		inp, clip         []int
+		idx, n            int
+		want, wantRemoved []int
+	}{{
+		inp: []int{1, 2, 3, 4}, idx: 1, n: 2, clip: []int{9}, want: []int{1, 9, 4}, wantRemoved: []int{2, 3},
+	}, {
+		inp: []int{1, 2, 3, 4}, idx: -1, n: 1, clip: []int{8, 9}, want: []int{1, 2, 3, 8, 9}, wantRemoved: []int{4},
+	}, {
+		inp: []int{1, 2, 3}, idx: 1, n: 0, clip: []int{9}, want: []int{1, 9, 2, 3}, wantRemoved: []int{},
+	}, {
+		inp: []int{1, 2, 3}, idx: 0, n: 3, clip: nil, want: []int{}, wantRemoved: []int{1, 2, 3},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, removed := SpliceSlice(tc.inp, tc.idx, tc.n, tc.clip)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if !reflect.DeepEqual(removed, tc.wantRemoved) {
+				t.Errorf("got removed %v, want %v", removed, tc.wantRemoved)
+			}
+			if len(got) < len(tc.inp) {
+				for _, val := range tc.inp[len(got):] {
+					if val != 0 {
+						t.Errorf("got nonzero tail %v", tc.inp[len(got):])
+						break
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package slices
+
+import "hash/maphash"
+
+// rollingBase is the multiplier for RollingHash's polynomial.
+// Arithmetic is modulo 2^64, so it must be odd.
+const rollingBase = 1099511628211
+
+// RollingHash is a polynomial hash of a fixed-length window of values
+// that can be updated in constant time as the window slides forward by one element,
+// as in the Rabin-Karp string-search algorithm.
+// Windows with equal contents have equal hashes;
+// windows with different contents usually, but not always, have different hashes.
+type RollingHash[T any] struct {
+	hash      func(T) uint64
+	sum, high uint64 // high is rollingBase^(len(window)-1)
+}
+
+// NewRollingHash returns a RollingHash of the values in window,
+// using hash to hash each individual value.
+// The window's length is fixed from then on.
+func NewRollingHash[T any](hash func(T) uint64, window []T) *RollingHash[T] {
+	h := &RollingHash[T]{hash: hash, high: 1}
+	for i, val := range window {
+		if i > 0 {
+			h.high *= rollingBase
+		}
+		h.sum = h.sum*rollingBase + hash(val)
+	}
+	return h
+}
+
+// Sum returns the hash of the current window.
+func (h *RollingHash[T]) Sum() uint64 {
+	return h.sum
+}
+
+// Roll slides the window forward by one element,
+// removing out (which must be the window's first element)
+// and appending in.
+func (h *RollingHash[T]) Roll(out, in T) {
+	h.sum = (h.sum-h.hash(out)*h.high)*rollingBase + h.hash(in)
+}
+
+// SearchRolling returns, in ascending order,
+// the index in s of every occurrence of sub,
+// including overlapping ones.
+// It is SearchRollingFunc using Go's builtin hashing and equality for T.
+//
+// Example: SearchRolling([a, a, a, b], [a, a]) -> [0, 1]
+func SearchRolling[T comparable](s, sub []T) []int {
+	seed := maphash.MakeSeed()
+	return SearchRollingFunc(
+		s,
+		sub,
+		func(val T) uint64 { return maphash.Comparable(seed, val) },
+		func(a, b T) bool { return a == b },
+	)
+}
+
+// SearchRollingFunc returns, in ascending order,
+// the index in s of every occurrence of sub,
+// including overlapping ones.
+// If sub is empty, it matches at every index from 0 through len(s).
+//
+// It uses the Rabin-Karp algorithm:
+// a RollingHash of each len(sub)-element window of s,
+// built with the given hash function,
+// is compared against the hash of sub.
+// Since different windows can have the same hash,
+// each candidate window is confirmed element by element with eq,
+// so the result never includes false matches.
+// Values that are equal according to eq must have the same hash.
+// The expected running time is proportional to len(s)+len(sub).
+func SearchRollingFunc[T any](s, sub []T, hash func(T) uint64, eq func(a, b T) bool) []int {
+	if len(sub) == 0 {
+		result := make([]int, len(s)+1)
+		for i := range result {
+			result[i] = i
+		}
+		return result
+	}
+	if len(sub) > len(s) {
+		return nil
+	}
+
+	var (
+		result []int
+		target = NewRollingHash(hash, sub).Sum()
+		h      = NewRollingHash(hash, s[:len(sub)])
+	)
+	for i := 0; ; i++ {
+		if h.Sum() == target && EqualPrefixFunc(s[i:], sub, len(sub), eq) {
+			result = append(result, i)
+		}
+		if i+len(sub) >= len(s) {
+			break
+		}
+		h.Roll(s[i], s[i+len(sub)])
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRollingHash(t *testing.T) {
+	hash := func(b byte) uint64 { return uint64(b) }
+	s := []byte("abcabc")
+
+	h := NewRollingHash(hash, s[:3])
+	first := h.Sum()
+	h.Roll(s[0], s[3])
+	h.Roll(s[1], s[4])
+	h.Roll(s[2], s[5])
+	if got := h.Sum(); got != first {
+		t.Errorf("got %d after rolling to an identical window, want %d", got, first)
+	}
+	if got := NewRollingHash(hash, s[3:]).Sum(); got != first {
+		t.Errorf("got %d for a fresh identical window, want %d", got, first)
+	}
+}
+
+func TestSearchRolling(t *testing.T) {
+	cases := []struct {
+		s, sub string
+		want   []int
+	}{{
+		s: "", sub: "a", want: nil,
+	}, {
+		s: "ab", sub: "", want: []int{0, 1, 2},
+	}, {
+		s: "aaab", sub: "aa", want: []int{0, 1},
+	}, {
+		s: "abcabcab", sub: "abc", want: []int{0, 3},
+	}, {
+		s: "abc", sub: "abc", want: []int{0},
+	}, {
+		s: "abc", sub: "abcd", want: nil,
+	}, {
+		s: "abc", sub: "x", want: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := SearchRolling([]byte(tc.s), []byte(tc.sub))
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// A hash with many collisions still produces only true matches.
+	got := SearchRollingFunc([]byte("abcba"), []byte("ab"), func(byte) uint64 { return 0 }, func(a, b byte) bool { return a == b })
+	if want := []int{0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
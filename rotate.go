@@ -0,0 +1,59 @@
+package slices
+
+// RotateToFirst rotates s in place so that the first element for which pred returns true
+// moves to position 0,
+// and reports whether there was such an element.
+// The elements before it move, in order, to the end of s.
+// If there is no such element, s is left unchanged and the result is false.
+//
+// The input slice is modified.
+//
+// Example: RotateToFirst([a, b, X, c], isUpper) -> [X, c, a, b], true
+func RotateToFirst[T any](s []T, pred func(T) bool) bool {
+	for i, val := range s {
+		if pred(val) {
+			rotateLeft(s, i)
+			return true
+		}
+	}
+	return false
+}
+
+// TouchMRU moves v to the front of order, inserting it if it is not already present,
+// and returns the result.
+// The other elements keep their relative order.
+// This lets a slice serve as a simple most-recently-used list,
+// with the least recently used element at the end.
+//
+// Each call scans order and shifts elements,
+// taking time proportional to len(order),
+// so this is suited only to short lists.
+//
+// The input slice is modified.
+//
+// Example: TouchMRU([a, b, c], c) -> [c, a, b]
+func TouchMRU[T comparable](order []T, v T) []T {
+	for i, val := range order {
+		if val == v {
+			rotateLeft(order[:i+1], i)
+			return order
+		}
+	}
+	return insert(order, 0, v)
+}
+
+// rotateLeft rotates s in place by n positions to the left,
+// so that s[n] moves to s[0],
+// where 0 <= n <= len(s).
+func rotateLeft[T any](s []T, n int) {
+	reverse(s[:n])
+	reverse(s[n:])
+	reverse(s)
+}
+
+// reverse reverses s in place.
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,48 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestRotateToFirst(t *testing.T) {
+	cases := []struct {
+		inp, want string
+		wantOK    bool
+	}{{
+		inp: "", want: "", wantOK: false,
+	}, {
+		inp: "abXc", want: "Xcab", wantOK: true,
+	}, {
+		inp: "XabY", want: "XabY", wantOK: true,
+	}, {
+		inp: "abcX", want: "Xabc", wantOK: true,
+	}, {
+		inp: "abc", want: "abc", wantOK: false,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			s := []rune(tc.inp)
+			ok := RotateToFirst(s, unicode.IsUpper)
+			if string(s) != tc.want {
+				t.Errorf("got %q, want %q", string(s), tc.want)
+			}
+			if ok != tc.wantOK {
+				t.Errorf("got ok %v, want %v", ok, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestTouchMRU(t *testing.T) {
+	var order []string
+	for _, v := range []string{"a", "b", "c", "a", "a", "c", "d"} {
+		order = TouchMRU(order, v)
+	}
+	if want := []string{"d", "c", "a", "b"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got %v, want %v", order, want)
+	}
+}
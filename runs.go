@@ -0,0 +1,156 @@
+package slices
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// LongestRun finds the longest run of consecutive equal elements in s.
+// It returns the value of the run's elements, the index at which it starts, and its length.
+// Ties go to the earliest run.
+//
+// If s is empty the length is 0
+// (and the value and start are the zero value and 0).
+//
+// Example: LongestRun([a, b, b, a, a, a, b]) -> a, 3, 3
+func LongestRun[T comparable](s []T) (value T, start, length int) {
+	eachRun(s, func(a, b T) bool { return a == b }, func(from, to int) {
+		if to-from > length {
+			value, start, length = s[from], from, to-from
+		}
+	})
+	return value, start, length
+}
+
+// eachRun calls f with the bounds [from, to) of each maximal run of s
+// in which eq holds for every adjacent pair of elements,
+// in order.
+func eachRun[T any](s []T, eq func(a, b T) bool, f func(from, to int)) {
+	if len(s) == 0 {
+		return
+	}
+	var from int
+	for i := 1; i < len(s); i++ {
+		if !eq(s[i-1], s[i]) {
+			f(from, i)
+			from = i
+		}
+	}
+	f(from, len(s))
+}
+
+// ExpandCounts returns a new slice in which each values[i] is repeated counts[i] times.
+// This is the inverse of run-length encoding.
+//
+// It is an error for values and counts to have different lengths,
+// or for any count to be negative.
+//
+// Example: ExpandCounts([a, b, c], [2, 0, 3]) -> [a, a, c, c, c]
+func ExpandCounts[T any](values []T, counts []int) ([]T, error) {
+	if len(values) != len(counts) {
+		return nil, fmt.Errorf("got %d values but %d counts", len(values), len(counts))
+	}
+	var n int
+	for i, count := range counts {
+		if count < 0 {
+			return nil, fmt.Errorf("count %d at index %d is negative", count, i)
+		}
+		n += count
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	result := make([]T, 0, n)
+	for i, val := range values {
+		for range counts[i] {
+			result = append(result, val)
+		}
+	}
+	return result, nil
+}
+
+// RunBoundaries returns the indices in s at which each run of consecutive equal elements begins.
+// For non-empty s the first boundary is always 0.
+// Consecutive boundaries (and len(s)) delimit the runs,
+// which can be obtained with SliceTo.
+//
+// Example: RunBoundaries([a, a, b, c, c, c]) -> [0, 2, 3]
+func RunBoundaries[T comparable](s []T) []int {
+	var result []int
+	eachRun(s, func(a, b T) bool { return a == b }, func(from, _ int) {
+		result = append(result, from)
+	})
+	return result
+}
+
+// MonotoneRuns counts the maximal strictly ascending and strictly descending runs in s.
+// A run consists of two or more consecutive elements,
+// each greater than (for ascending) or less than (for descending) the one before.
+// A peak or valley element ends one run and begins the next.
+// Equal adjacent elements belong to neither kind of run.
+// Slices with fewer than two elements have no runs.
+//
+// Example: MonotoneRuns([1, 2, 3, 2, 1, 1, 2]) -> 2, 1
+func MonotoneRuns[T cmp.Ordered](s []T) (ascending, descending int) {
+	var prev int
+	for i := 1; i < len(s); i++ {
+		dir := cmp.Compare(s[i], s[i-1])
+		if dir != prev {
+			switch dir {
+			case 1:
+				ascending++
+			case -1:
+				descending++
+			}
+		}
+		prev = dir
+	}
+	return ascending, descending
+}
+
+// LongestPlateau finds the longest run of consecutive elements of s
+// in which each element differs from the one before it by at most tolerance.
+// It returns the index at which the run starts and its length.
+// Ties go to the earliest run.
+// If s is empty the length is 0.
+//
+// Note that only adjacent elements are compared,
+// not each element against the start of the run,
+// so a run may drift gradually by more than tolerance overall.
+//
+// Example: LongestPlateau([1, 5, 5.1, 5.2, 9], 0.15) -> 1, 3
+func LongestPlateau[T Number](s []T, tolerance T) (start, length int) {
+	within := func(a, b T) bool {
+		if a > b {
+			return a-b <= tolerance
+		}
+		return b-a <= tolerance
+	}
+	eachRun(s, within, func(from, to int) {
+		if to-from > length {
+			start, length = from, to-from
+		}
+	})
+	return start, length
+}
+
+// CountRunsFunc returns the number of maximal runs of consecutive elements of s
+// for which pred returns true.
+// Adjacent elements satisfying pred belong to the same run;
+// an element not satisfying it separates runs.
+//
+// Example: CountRunsFunc([1, 3, 2, 5, 7, 9, 4], isOdd) -> 2
+func CountRunsFunc[T any](s []T, pred func(T) bool) int {
+	var (
+		result int
+		inRun  bool
+	)
+	for _, val := range s {
+		ok := pred(val)
+		if ok && !inRun {
+			result++
+		}
+		inRun = ok
+	}
+	return result
+}
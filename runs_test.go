@@ -0,0 +1,180 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLongestRun(t *testing.T) {
+	cases := []struct {
+		inp                   string
+		wantVal               byte
+		wantStart, wantLength int
+	}{{
+		inp: "", wantVal: 0, wantStart: 0, wantLength: 0,
+	}, {
+		inp: "a", wantVal: 'a', wantStart: 0, wantLength: 1,
+	}, {
+		inp: "abbaaab", wantVal: 'a', wantStart: 3, wantLength: 3,
+	}, {
+		inp: "aabbcc", wantVal: 'a', wantStart: 0, wantLength: 2,
+	}, {
+		inp: "abccc", wantVal: 'c', wantStart: 2, wantLength: 3,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			val, start, length := LongestRun([]byte(tc.inp))
+			if val != tc.wantVal || start != tc.wantStart || length != tc.wantLength {
+				t.Errorf("got %q, %d, %d; want %q, %d, %d", val, start, length, tc.wantVal, tc.wantStart, tc.wantLength)
+			}
+		})
+	}
+}
+
+func TestExpandCounts(t *testing.T) {
+	cases := []struct {
+		values  string
+		counts  []int
+		want    []byte
+		wantErr bool
+	}{{
+		values: "", counts: nil, want: nil,
+	}, {
+		values: "abc", counts: []int{2, 0, 3}, want: []byte("aaccc"),
+	}, {
+		values: "ab", counts: []int{0, 0}, want: nil,
+	}, {
+		values: "ab", counts: []int{1}, wantErr: true,
+	}, {
+		values: "ab", counts: []int{1, -1}, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := ExpandCounts([]byte(tc.values), tc.counts)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunBoundaries(t *testing.T) {
+	cases := []struct {
+		inp  string
+		want []int
+	}{{
+		inp: "", want: nil,
+	}, {
+		inp: "a", want: []int{0},
+	}, {
+		inp: "aabccc", want: []int{0, 2, 3},
+	}, {
+		inp: "abab", want: []int{0, 1, 2, 3},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := RunBoundaries([]byte(tc.inp))
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMonotoneRuns(t *testing.T) {
+	cases := []struct {
+		inp               []int
+		wantAsc, wantDesc int
+	}{{
+		inp: nil, wantAsc: 0, wantDesc: 0,
+	}, {
+		inp: []int{1}, wantAsc: 0, wantDesc: 0,
+	}, {
+		inp: []int{1, 1, 1}, wantAsc: 0, wantDesc: 0,
+	}, {
+		inp: []int{1, 2, 3, 2, 1, 1, 2}, wantAsc: 2, wantDesc: 1,
+	}, {
+		inp: []int{1, 3, 2, 4, 3}, wantAsc: 2, wantDesc: 2,
+	}, {
+		inp: []int{1, 2, 2, 3}, wantAsc: 2, wantDesc: 0,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			asc, desc := MonotoneRuns(tc.inp)
+			if asc != tc.wantAsc || desc != tc.wantDesc {
+				t.Errorf("got %d, %d; want %d, %d", asc, desc, tc.wantAsc, tc.wantDesc)
+			}
+		})
+	}
+}
+
+func TestLongestPlateau(t *testing.T) {
+	cases := []struct {
+		inp                   []float64
+		tolerance             float64
+		wantStart, wantLength int
+	}{{
+		inp: nil, tolerance: 1, wantStart: 0, wantLength: 0,
+	}, {
+		inp: []float64{1, 5, 5.1, 5.2, 9}, tolerance: 0.15, wantStart: 1, wantLength: 3,
+	}, {
+		inp: []float64{1, 2, 3}, tolerance: 0.5, wantStart: 0, wantLength: 1,
+	}, {
+		inp: []float64{1, 2, 3, 10, 11}, tolerance: 1, wantStart: 0, wantLength: 3,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			start, length := LongestPlateau(tc.inp, tc.tolerance)
+			if start != tc.wantStart || length != tc.wantLength {
+				t.Errorf("got %d, %d; want %d, %d", start, length, tc.wantStart, tc.wantLength)
+			}
+		})
+	}
+
+	// Unsigned types do not wrap around.
+	if start, length := LongestPlateau([]uint{5, 1, 2, 3}, 1); start != 1 || length != 3 {
+		t.Errorf("got %d, %d; want 1, 3", start, length)
+	}
+}
+
+func TestCountRunsFunc(t *testing.T) {
+	isOdd := func(n int) bool { return n%2 != 0 }
+
+	cases := []struct {
+		inp  []int
+		want int
+	}{{
+		inp: nil, want: 0,
+	}, {
+		inp: []int{2, 4}, want: 0,
+	}, {
+		inp: []int{1, 3, 2, 5, 7, 9, 4}, want: 2,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, want: 3,
+	}, {
+		inp: []int{1, 1, 1}, want: 1,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := CountRunsFunc(tc.inp, isOdd); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package slices
+
+import "fmt"
+
+// SampleEvery returns a new slice containing every step'th element of s,
+// beginning with s[0]
+// (so a step of 2 selects the elements at even indices).
+// The last element of s is included only if its index is a multiple of step.
+//
+// It is an error for step to be less than 1.
+//
+// Example: SampleEvery([a, b, c, d, e], 2) -> [a, c, e]
+func SampleEvery[T any](s []T, step int) ([]T, error) {
+	return SampleEveryFrom(s, 0, step)
+}
+
+// SampleEveryFrom returns a new slice containing every step'th element of s,
+// beginning with s[offset].
+// The last element of s is included only if its distance from offset is a multiple of step.
+//
+// If offset < 0 it counts from the end of s.
+//
+// It is an error for step to be less than 1.
+//
+// Example: SampleEveryFrom([a, b, c, d, e], 1, 2) -> [b, d]
+func SampleEveryFrom[T any](s []T, offset, step int) ([]T, error) {
+	if step < 1 {
+		return nil, fmt.Errorf("step %d is not positive", step)
+	}
+	if offset < 0 {
+		offset += len(s)
+	}
+	if offset >= len(s) {
+		return nil, nil
+	}
+	result := make([]T, 0, (len(s)-offset+step-1)/step)
+	for i := offset; i < len(s); i += step {
+		result = append(result, s[i])
+	}
+	return result, nil
+}
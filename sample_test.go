@@ -0,0 +1,56 @@
+package slices
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSampleEveryFrom(t *testing.T) {
+	cases := []struct {
+		inp          string
+		offset, step int
+		want         string
+		wantErr      bool
+	}{{
+		inp: "", offset: 0, step: 2, want: "",
+	}, {
+		inp: "abcde", offset: 0, step: 2, want: "ace",
+	}, {
+		inp: "abcdef", offset: 0, step: 2, want: "ace",
+	}, {
+		inp: "abcde", offset: 1, step: 2, want: "bd",
+	}, {
+		inp: "abcde", offset: -2, step: 1, want: "de",
+	}, {
+		inp: "abcde", offset: 0, step: 10, want: "a",
+	}, {
+		inp: "abcde", offset: 0, step: 0, wantErr: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got, err := SampleEveryFrom([]byte(tc.inp), tc.offset, tc.step)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+			if tc.offset == 0 {
+				got, err := SampleEvery([]byte(tc.inp), tc.step)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tc.want {
+					t.Errorf("SampleEvery: got %q, want %q", got, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package slices
+
+// ScanUntil produces the successive results of folding the elements of s into an accumulator,
+// stopping when f says to.
+//
+// The accumulator starts as init.
+// For each element of s in turn,
+// f is called with the accumulator and the element,
+// and returns a new accumulator value and a boolean.
+// If the boolean is true,
+// the new value is appended to the result and becomes the accumulator.
+// If it is false,
+// ScanUntil stops and returns the result so far,
+// which excludes the value from the stopping step.
+//
+// Example: ScanUntil([3, 4, 5, 6], 0, add while sum <= 10) -> [3, 7]
+func ScanUntil[T, U any](s []T, init U, f func(acc U, elem T) (U, bool)) []U {
+	var (
+		result []U
+		acc    = init
+	)
+	for _, val := range s {
+		next, ok := f(acc, val)
+		if !ok {
+			break
+		}
+		acc = next
+		result = append(result, acc)
+	}
+	return result
+}
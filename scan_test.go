@@ -0,0 +1,36 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestScanUntil(t *testing.T) {
+	addUpTo10 := func(acc, n int) (int, bool) {
+		acc += n
+		return acc, acc <= 10
+	}
+
+	cases := []struct {
+		inp  []int
+		want []int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []int{3, 4, 5, 6}, want: []int{3, 7},
+	}, {
+		inp: []int{1, 2, 3}, want: []int{1, 3, 6},
+	}, {
+		inp: []int{11, 1}, want: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ScanUntil(tc.inp, 0, addUpTo10)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
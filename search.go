@@ -0,0 +1,111 @@
+package slices
+
+import (
+	"iter"
+	"sort"
+)
+
+// FindAllSubslices returns an iterator over the starting indices
+// of the non-overlapping occurrences of sub in s,
+// from left to right.
+// Occurrences are found lazily,
+// so a caller that stops iterating early does not pay to scan the rest of s.
+//
+// If sub is empty,
+// it matches at every position,
+// and the iterator produces each index from 0 through len(s).
+//
+// Example: FindAllSubslices([a, a, a, b, a, a], [a, a]) -> 0, 4
+func FindAllSubslices[T comparable](s, sub []T) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if len(sub) == 0 {
+			for i := 0; i <= len(s); i++ {
+				if !yield(i) {
+					return
+				}
+			}
+			return
+		}
+		for i := 0; i+len(sub) <= len(s); {
+			if !EqualPrefix(s[i:], sub, len(sub)) {
+				i++
+				continue
+			}
+			if !yield(i) {
+				return
+			}
+			i += len(sub)
+		}
+	}
+}
+
+// PartitionPoint returns the index of the first element of s for which pred is false,
+// or len(s) if there is none.
+// It uses binary search, taking time proportional to log(len(s)).
+//
+// The input slice must be partitioned by pred:
+// every element for which pred is true must precede every element for which it is false.
+// Otherwise the result is not meaningful.
+//
+// Example: PartitionPoint([1, 3, 5, 2, 4], isOdd) -> 3
+func PartitionPoint[T any](s []T, pred func(T) bool) int {
+	return sort.Search(len(s), func(i int) bool { return !pred(s[i]) })
+}
+
+// IsSubsequence tells whether the elements of sub appear in s in the same order,
+// though not necessarily contiguously.
+// An empty sub is a subsequence of anything.
+// This takes time proportional to len(s).
+//
+// Example: IsSubsequence([a, c], [a, b, c]) -> true
+func IsSubsequence[T comparable](sub, s []T) bool {
+	var i int
+	for _, val := range s {
+		if i == len(sub) {
+			break
+		}
+		if val == sub[i] {
+			i++
+		}
+	}
+	return i == len(sub)
+}
+
+// LastIndexOfSubslice returns the index in s at which the last occurrence of sub begins,
+// or -1 if sub does not occur in s.
+// It scans s from the right, stopping at the first match it finds.
+//
+// As with FindAllSubslices, an empty sub matches at every position,
+// so in that case the result is len(s).
+//
+// Example: LastIndexOfSubslice([a, b, a, b, c], [a, b]) -> 2
+func LastIndexOfSubslice[T comparable](s, sub []T) int {
+	for i := len(s) - len(sub); i >= 0; i-- {
+		if EqualPrefix(s[i:], sub, len(sub)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndicesOfAny returns, in ascending order,
+// the index of every element of s that is equal to any of vals.
+// If there are none, the result is empty.
+//
+// The values are placed in a set,
+// so this takes time proportional to len(s)+len(vals).
+//
+// Example: IndicesOfAny([a, b, c, a, d], a, d) -> [0, 3, 4]
+func IndicesOfAny[T comparable](s []T, vals ...T) []int {
+	set := make(map[T]struct{}, len(vals))
+	for _, val := range vals {
+		set[val] = struct{}{}
+	}
+	var result []int
+	for i, val := range s {
+		if _, ok := set[val]; ok {
+			result = append(result, i)
+		}
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFindAllSubslices(t *testing.T) {
+	cases := []struct {
+		s, sub string
+		want   []int
+	}{{
+		s: "", sub: "a", want: nil,
+	}, {
+		s: "aaabaa", sub: "aa", want: []int{0, 4},
+	}, {
+		s: "abcabc", sub: "abc", want: []int{0, 3},
+	}, {
+		s: "abc", sub: "d", want: nil,
+	}, {
+		s: "ab", sub: "", want: []int{0, 1, 2},
+	}, {
+		s: "ab", sub: "abc", want: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			var got []int
+			for idx := range FindAllSubslices([]byte(tc.s), []byte(tc.sub)) {
+				got = append(got, idx)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// Stopping early.
+	var got []int
+	for idx := range FindAllSubslices([]byte("aaaaaa"), []byte("a")) {
+		got = append(got, idx)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []int{0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPartitionPoint(t *testing.T) {
+	isOdd := func(n int) bool { return n%2 != 0 }
+
+	cases := []struct {
+		inp  []int
+		want int
+	}{{
+		inp: nil, want: 0,
+	}, {
+		inp: []int{1, 3, 5, 2, 4}, want: 3,
+	}, {
+		inp: []int{1, 3, 5}, want: 3,
+	}, {
+		inp: []int{2, 4}, want: 0,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := PartitionPoint(tc.inp, isOdd); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSubsequence(t *testing.T) {
+	cases := []struct {
+		sub, s string
+		want   bool
+	}{{
+		sub: "", s: "", want: true,
+	}, {
+		sub: "", s: "abc", want: true,
+	}, {
+		sub: "ac", s: "abc", want: true,
+	}, {
+		sub: "ca", s: "abc", want: false,
+	}, {
+		sub: "aa", s: "aba", want: true,
+	}, {
+		sub: "abcd", s: "abc", want: false,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := IsSubsequence([]byte(tc.sub), []byte(tc.s)); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLastIndexOfSubslice(t *testing.T) {
+	cases := []struct {
+		s, sub string
+		want   int
+	}{{
+		s: "", sub: "", want: 0,
+	}, {
+		s: "abc", sub: "", want: 3,
+	}, {
+		s: "ababc", sub: "ab", want: 2,
+	}, {
+		s: "ababc", sub: "bc", want: 3,
+	}, {
+		s: "ababc", sub: "ba", want: 1,
+	}, {
+		s: "abc", sub: "abcd", want: -1,
+	}, {
+		s: "abc", sub: "x", want: -1,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := LastIndexOfSubslice([]byte(tc.s), []byte(tc.sub)); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIndicesOfAny(t *testing.T) {
+	cases := []struct {
+		s, vals string
+		want    []int
+	}{{
+		s: "", vals: "a", want: nil,
+	}, {
+		s: "abcad", vals: "ad", want: []int{0, 3, 4},
+	}, {
+		s: "abc", vals: "", want: nil,
+	}, {
+		s: "abc", vals: "xyz", want: nil,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := IndicesOfAny([]byte(tc.s), []byte(tc.vals)...)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package slices
+
+import "math/rand"
+
+// ShuffleRange randomly permutes the elements of s beginning at position from and ending before position to,
+// leaving the rest of s alone.
+// It uses r as its source of randomness,
+// or the default source in math/rand if r is nil.
+//
+// If from < 0 it counts from the end of s.
+// If to <= 0 it counts from the end of s.
+//
+// The input slice is modified.
+func ShuffleRange[T any](s []T, from, to int, r *rand.Rand) {
+	if from < 0 {
+		from += len(s)
+	}
+	if to < 0 {
+		to += len(s)
+	} else if to == 0 {
+		to = len(s)
+	}
+
+	sub := s[from:to]
+	swap := func(i, j int) { sub[i], sub[j] = sub[j], sub[i] }
+	if r == nil {
+		rand.Shuffle(len(sub), swap)
+	} else {
+		r.Shuffle(len(sub), swap)
+	}
+}
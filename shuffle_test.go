@@ -0,0 +1,32 @@
+package slices
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestShuffleRange(t *testing.T) {
+	inp := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	r := rand.New(rand.NewSource(1))
+
+	got := append([]int{}, inp...)
+	ShuffleRange(got, 2, -2, r)
+
+	if !reflect.DeepEqual(got[:2], inp[:2]) || !reflect.DeepEqual(got[8:], inp[8:]) {
+		t.Errorf("elements outside the range moved: %v", got)
+	}
+	mid := append([]int{}, got[2:8]...)
+	sort.Ints(mid)
+	if !reflect.DeepEqual(mid, inp[2:8]) {
+		t.Errorf("range is not a permutation of the original: %v", got)
+	}
+
+	// The same seed produces the same shuffle.
+	again := append([]int{}, inp...)
+	ShuffleRange(again, 2, -2, rand.New(rand.NewSource(1)))
+	if !reflect.DeepEqual(again, got) {
+		t.Errorf("got %v, want %v", again, got)
+	}
+}
@@ -49,6 +49,25 @@ func Insert[T any](s []T, idx int, vals ...T) []T {
 	return insert(s, idx, vals...)
 }
 
+// Paste inserts the contents of clip at the idx'th location in s and returns the result.
+// After the paste, the first pasted value has position idx.
+// It is the same as Insert(s, idx, clip...)
+// and is the counterpart of Cut.
+//
+// The contents of clip are copied into s,
+// which may be reallocated if it lacks the capacity to hold them.
+// The clip slice must not share an underlying array with s.
+//
+// If idx < 0, it counts from the end of s.
+//
+// The input slice is modified.
+func Paste[T any](s []T, idx int, clip []T) []T {
+	if idx < 0 {
+		idx += len(s)
+	}
+	return insert(s, idx, clip...)
+}
+
 func insert[T any](s []T, idx int, vals ...T) []T {
 	// Make s long enough.
 	s = append(s, vals...)
@@ -0,0 +1,135 @@
+package slices
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SortUniqBy sorts s by the value that key derives from each element,
+// then removes elements whose key duplicates that of an earlier element.
+// It returns the result.
+//
+// The sort is stable,
+// so of the elements sharing a key,
+// the one that appeared first in s is the one that is kept.
+//
+// The input slice is modified.
+// The vacated positions at the end of s are set to the zero value.
+//
+// Example: SortUniqBy([b2, a1, b1, a2], first letter) -> [a1, b2]
+func SortUniqBy[T any, K cmp.Ordered](s []T, key func(T) K) []T {
+	if len(s) == 0 {
+		return s
+	}
+
+	keys := make([]K, len(s))
+	for i, val := range s {
+		keys[i] = key(val)
+	}
+	sort.Stable(keyedSorter[T, K]{s: s, keys: keys})
+
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if keys[i] == keys[n-1] {
+			continue
+		}
+		s[n], keys[n] = s[i], keys[i]
+		n++
+	}
+	clear(s[n:])
+	return s[:n]
+}
+
+// SortedByResult returns a new slice containing the elements of s
+// stably sorted by the key that f derives from each.
+//
+// Unlike a sort that derives keys during comparisons,
+// SortedByResult calls f exactly once per element,
+// which matters when f is expensive.
+// It does this by allocating an auxiliary slice of the keys
+// (the "decorate-sort-undecorate" idiom, a.k.a. the Schwartzian transform).
+//
+// The input slice is not modified.
+func SortedByResult[T any, K cmp.Ordered](s []T, f func(T) K) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	result := make([]T, len(s))
+	copy(result, s)
+	keys := make([]K, len(s))
+	for i, val := range s {
+		keys[i] = f(val)
+	}
+	sort.Stable(keyedSorter[T, K]{s: result, keys: keys})
+	return result
+}
+
+// Argsort returns the permutation of indices of s that would sort it in ascending order.
+// That is, s[result[0]] is the smallest element of s, s[result[1]] is the next smallest, and so on.
+// The sort is stable, so indices of equal elements appear in ascending order.
+// This allows several parallel slices to be sorted consistently according to the values in one of them.
+//
+// The input slice is not modified.
+func Argsort[T cmp.Ordered](s []T) []int {
+	return ArgsortFunc(s, cmp.Compare[T])
+}
+
+// ArgsortFunc returns the permutation of indices of s that would sort it in ascending order,
+// as determined by the comparison function cmp,
+// which returns a negative number if a < b, a positive number if a > b, and zero if they're equal.
+// The sort is stable, so indices of equal elements appear in ascending order.
+//
+// The input slice is not modified.
+func ArgsortFunc[T any](s []T, cmp func(a, b T) int) []int {
+	result := make([]int, len(s))
+	for i := range result {
+		result[i] = i
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return cmp(s[result[i]], s[result[j]]) < 0
+	})
+	return result
+}
+
+// Rank returns the 0-based rank of each element of s,
+// i.e. the position it would occupy if s were stably sorted in ascending order.
+// Ties are ranked ordinally:
+// of two equal elements, the one with the lower index in s has the lower rank.
+// The result is therefore always a permutation of 0 through len(s)-1.
+//
+// The input slice is not modified.
+//
+// Example: Rank([30, 10, 20, 10]) -> [3, 0, 2, 1]
+func Rank[T cmp.Ordered](s []T) []int {
+	return RankFunc(s, cmp.Compare[T])
+}
+
+// RankFunc returns the 0-based rank of each element of s,
+// i.e. the position it would occupy if s were stably sorted
+// according to the comparison function cmp
+// (which is as for ArgsortFunc).
+// Ties are ranked ordinally, as for Rank.
+//
+// The input slice is not modified.
+func RankFunc[T any](s []T, cmp func(a, b T) int) []int {
+	perm := ArgsortFunc(s, cmp)
+	result := make([]int, len(s))
+	for rank, idx := range perm {
+		result[idx] = rank
+	}
+	return result
+}
+
+// keyedSorter sorts s according to the precomputed keys,
+// keeping the two slices in step.
+type keyedSorter[T any, K cmp.Ordered] struct {
+	s    []T
+	keys []K
+}
+
+func (ks keyedSorter[T, K]) Len() int           { return len(ks.s) }
+func (ks keyedSorter[T, K]) Less(i, j int) bool { return cmp.Less(ks.keys[i], ks.keys[j]) }
+func (ks keyedSorter[T, K]) Swap(i, j int) {
+	ks.s[i], ks.s[j] = ks.s[j], ks.s[i]
+	ks.keys[i], ks.keys[j] = ks.keys[j], ks.keys[i]
+}
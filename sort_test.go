@@ -0,0 +1,102 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSortUniqBy(t *testing.T) {
+	cases := []struct {
+		inp, want, wantTail []string
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []string{"b2", "a1", "b1", "a2"}, want: []string{"a1", "b2"}, wantTail: []string{"", ""},
+	}, {
+		inp: []string{"c1", "b1", "a1"}, want: []string{"a1", "b1", "c1"}, wantTail: []string{},
+	}, {
+		inp: []string{"a3", "a2", "a1"}, want: []string{"a3"}, wantTail: []string{"", ""},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := SortUniqBy(tc.inp, func(s string) byte { return s[0] })
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if tail := tc.inp[len(got):]; tc.inp != nil && !reflect.DeepEqual(tail, tc.wantTail) {
+				t.Errorf("got tail %v, want %v", tail, tc.wantTail)
+			}
+		})
+	}
+}
+
+func TestSortedByResult(t *testing.T) {
+	inp := []string{"ccc", "a", "bb", "dd", ""}
+	orig := append([]string{}, inp...)
+
+	var calls int
+	got := SortedByResult(inp, func(s string) int {
+		calls++
+		return len(s)
+	})
+	want := []string{"", "a", "bb", "dd", "ccc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if calls != len(inp) {
+		t.Errorf("got %d calls to f, want %d", calls, len(inp))
+	}
+	if !reflect.DeepEqual(inp, orig) {
+		t.Errorf("input was modified: %v", inp)
+	}
+}
+
+func TestArgsort(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		want []int
+	}{{
+		inp: nil, want: []int{},
+	}, {
+		inp: []int{30, 10, 20}, want: []int{1, 2, 0},
+	}, {
+		inp: []int{2, 1, 2, 1}, want: []int{1, 3, 0, 2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			orig := append([]int{}, tc.inp...)
+			got := Argsort(tc.inp)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if len(tc.inp) > 0 && !reflect.DeepEqual(tc.inp, orig) {
+				t.Errorf("input was modified: %v", tc.inp)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		want []int
+	}{{
+		inp: nil, want: []int{},
+	}, {
+		inp: []int{30, 10, 20, 10}, want: []int{3, 0, 2, 1},
+	}, {
+		inp: []int{1, 2, 3}, want: []int{0, 1, 2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := Rank(tc.inp)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package slices
+
+import "cmp"
+
+// UnionSorted returns a new slice containing the values present in a or b or both.
+//
+// Both input slices must be sorted in ascending order;
+// otherwise the result is not meaningful.
+// The result is sorted in ascending order and contains no duplicates,
+// even if the inputs do.
+// This takes time proportional to len(a)+len(b).
+//
+// Example: UnionSorted([1, 2, 2, 4], [2, 3]) -> [1, 2, 3, 4]
+func UnionSorted[T cmp.Ordered](a, b []T) []T {
+	var result []T
+	appendNew := func(val T) {
+		if len(result) == 0 || result[len(result)-1] != val {
+			result = append(result, val)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			appendNew(a[i])
+			i++
+		case b[j] < a[i]:
+			appendNew(b[j])
+			j++
+		default:
+			appendNew(a[i])
+			i++
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		appendNew(a[i])
+	}
+	for ; j < len(b); j++ {
+		appendNew(b[j])
+	}
+	return result
+}
+
+// IntersectionSorted returns a new slice containing the values present in both a and b.
+//
+// Both input slices must be sorted in ascending order;
+// otherwise the result is not meaningful.
+// The result is sorted in ascending order and contains no duplicates,
+// even if the inputs do.
+// This takes time proportional to len(a)+len(b).
+//
+// Example: IntersectionSorted([1, 2, 2, 4], [2, 3, 4]) -> [2, 4]
+func IntersectionSorted[T cmp.Ordered](a, b []T) []T {
+	var result []T
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			if len(result) == 0 || result[len(result)-1] != a[i] {
+				result = append(result, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// DifferenceSorted returns a new slice containing the values present in a but not in b.
+//
+// Both input slices must be sorted in ascending order;
+// otherwise the result is not meaningful.
+// The result is sorted in ascending order and contains no duplicates,
+// even if the inputs do.
+// This takes time proportional to len(a)+len(b).
+//
+// Example: DifferenceSorted([1, 2, 2, 4], [2, 3]) -> [1, 4]
+func DifferenceSorted[T cmp.Ordered](a, b []T) []T {
+	var result []T
+
+	var j int
+	for _, val := range a {
+		for j < len(b) && b[j] < val {
+			j++
+		}
+		if j < len(b) && b[j] == val {
+			continue
+		}
+		if len(result) == 0 || result[len(result)-1] != val {
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+// DedupSorted removes duplicate values from s and returns the result.
+// The vacated positions at the end of s are set to the zero value.
+//
+// The input slice must be sorted,
+// so that equal values are adjacent.
+// If it isn't, only adjacent duplicates are removed.
+// This takes time proportional to len(s).
+//
+// The input slice is modified.
+//
+// Example: DedupSorted([1, 1, 2, 3, 3, 3]) -> [1, 2, 3]
+func DedupSorted[T comparable](s []T) []T {
+	if len(s) == 0 {
+		return s
+	}
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[n-1] {
+			s[n] = s[i]
+			n++
+		}
+	}
+	clear(s[n:])
+	return s[:n]
+}
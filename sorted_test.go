@@ -0,0 +1,84 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSortedSetOps(t *testing.T) {
+	cases := []struct {
+		a, b                 []int
+		wantUnion, wantInter []int
+		wantDiff             []int
+	}{{
+		a: nil, b: nil, wantUnion: nil, wantInter: nil, wantDiff: nil,
+	}, {
+		a:         []int{1, 2, 2, 4},
+		b:         []int{2, 3},
+		wantUnion: []int{1, 2, 3, 4},
+		wantInter: []int{2},
+		wantDiff:  []int{1, 4},
+	}, {
+		a:         []int{1, 1, 5},
+		b:         nil,
+		wantUnion: []int{1, 5},
+		wantInter: nil,
+		wantDiff:  []int{1, 5},
+	}, {
+		a:         nil,
+		b:         []int{3, 3},
+		wantUnion: []int{3},
+		wantInter: nil,
+		wantDiff:  nil,
+	}, {
+		a:         []int{1, 3, 5, 7},
+		b:         []int{3, 4, 5, 5, 9},
+		wantUnion: []int{1, 3, 4, 5, 7, 9},
+		wantInter: []int{3, 5},
+		wantDiff:  []int{1, 7},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := UnionSorted(tc.a, tc.b); !reflect.DeepEqual(got, tc.wantUnion) {
+				t.Errorf("union: got %v, want %v", got, tc.wantUnion)
+			}
+			if got := IntersectionSorted(tc.a, tc.b); !reflect.DeepEqual(got, tc.wantInter) {
+				t.Errorf("intersection: got %v, want %v", got, tc.wantInter)
+			}
+			if got := DifferenceSorted(tc.a, tc.b); !reflect.DeepEqual(got, tc.wantDiff) {
+				t.Errorf("difference: got %v, want %v", got, tc.wantDiff)
+			}
+		})
+	}
+}
+
+func TestDedupSorted(t *testing.T) {
+	cases := []struct {
+		inp, want []int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []int{1}, want: []int{1},
+	}, {
+		inp: []int{1, 1, 2, 3, 3, 3}, want: []int{1, 2, 3},
+	}, {
+		inp: []int{1, 2, 1, 1}, want: []int{1, 2, 1},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := DedupSorted(tc.inp)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			for _, val := range tc.inp[len(got):] {
+				if val != 0 {
+					t.Errorf("got nonzero tail %v", tc.inp[len(got):])
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package slices
+
+// SplitWhen splits s just before the first element for which pred returns true.
+// That element begins after.
+// If there is no such element,
+// before is all of s and after is empty.
+//
+// The results are subslices of s, not copies.
+// The capacity of before is limited to its length,
+// so appending to it does not overwrite after.
+//
+// Example: SplitWhen([a, b, X, c, Y], isUpper) -> [a, b], [X, c, Y]
+func SplitWhen[T any](s []T, pred func(T) bool) (before, after []T) {
+	for i, val := range s {
+		if pred(val) {
+			return s[:i:i], s[i:]
+		}
+	}
+	return s, s[len(s):]
+}
+
+// SplitByCumulativeWeight divides s into consecutive segments
+// according to the running total of the weights of its elements.
+// Each time the running total reaches or passes the next value in targets,
+// the current segment ends (including the element that reached it)
+// and a new one begins.
+// If a single element carries the total past several targets,
+// they produce only one split, so no segment is empty.
+// Any elements remaining after the last target is reached form a final segment.
+//
+// The targets must be in ascending order;
+// otherwise the result is not meaningful.
+//
+// Each segment is a subslice of s, not a copy.
+//
+// Example: SplitByCumulativeWeight([3, 1, 4, 1, 5, 9], identity, 4, 10) -> [[3, 1], [4, 1, 5], [9]]
+func SplitByCumulativeWeight[T any](s []T, weight func(T) int, targets ...int) [][]T {
+	var (
+		result       [][]T
+		start, total int
+	)
+	for i, val := range s {
+		if len(targets) == 0 {
+			break
+		}
+		total += weight(val)
+		if total < targets[0] {
+			continue
+		}
+		for len(targets) > 0 && total >= targets[0] {
+			targets = targets[1:]
+		}
+		result = append(result, s[start:i+1])
+		start = i + 1
+	}
+	if start < len(s) {
+		result = append(result, s[start:])
+	}
+	return result
+}
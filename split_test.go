@@ -0,0 +1,68 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestSplitWhen(t *testing.T) {
+	cases := []struct {
+		inp                   string
+		wantBefore, wantAfter string
+	}{{
+		inp: "", wantBefore: "", wantAfter: "",
+	}, {
+		inp: "abXcY", wantBefore: "ab", wantAfter: "XcY",
+	}, {
+		inp: "Xab", wantBefore: "", wantAfter: "Xab",
+	}, {
+		inp: "abc", wantBefore: "abc", wantAfter: "",
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			before, after := SplitWhen([]rune(tc.inp), unicode.IsUpper)
+			if string(before) != tc.wantBefore || string(after) != tc.wantAfter {
+				t.Errorf("got %q, %q; want %q, %q", string(before), string(after), tc.wantBefore, tc.wantAfter)
+			}
+		})
+	}
+
+	s := []rune("abXc")
+	before, after := SplitWhen(s, unicode.IsUpper)
+	_ = append(before, 'z')
+	if string(after) != "Xc" {
+		t.Errorf("appending to before changed after to %q", string(after))
+	}
+}
+
+func TestSplitByCumulativeWeight(t *testing.T) {
+	identity := func(n int) int { return n }
+
+	cases := []struct {
+		inp     []int
+		targets []int
+		want    [][]int
+	}{{
+		inp: nil, targets: []int{1}, want: nil,
+	}, {
+		inp: []int{3, 1, 4, 1, 5, 9}, targets: []int{4, 10}, want: [][]int{{3, 1}, {4, 1, 5}, {9}},
+	}, {
+		inp: []int{3, 1, 4}, targets: nil, want: [][]int{{3, 1, 4}},
+	}, {
+		inp: []int{1, 10, 1}, targets: []int{2, 5, 8}, want: [][]int{{1, 10}, {1}},
+	}, {
+		inp: []int{1, 1}, targets: []int{2}, want: [][]int{{1, 1}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := SplitByCumulativeWeight(tc.inp, identity, tc.targets...)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package slices
+
+import (
+	"math"
+	"sort"
+)
+
+// Quartiles returns the three quartile cut points of the elements of s:
+// the 25th percentile, the median, and the 75th percentile.
+//
+// Each is computed by linear interpolation between the closest ranks:
+// the p'th quantile of n sorted values lies at position p*(n-1),
+// and a fractional position is interpolated between the values on either side.
+// (This is the method used by default in NumPy and R, and by Excel's QUARTILE.INC.)
+//
+// If s is empty, all three results are NaN.
+//
+// The input slice is not modified.
+func Quartiles[T Number](s []T) (q1, q2, q3 float64) {
+	sorted := sortedFloats(s)
+	return quantileSorted(sorted, 0.25), quantileSorted(sorted, 0.5), quantileSorted(sorted, 0.75)
+}
+
+// IQR returns the interquartile range of the elements of s,
+// i.e. q3-q1 as computed by Quartiles.
+//
+// If s is empty, the result is NaN.
+//
+// The input slice is not modified.
+func IQR[T Number](s []T) float64 {
+	q1, _, q3 := Quartiles(s)
+	return q3 - q1
+}
+
+// OutlierIndices returns, in ascending order,
+// the indices of the elements of s lying outside the Tukey fences
+//
+//	[q1 - k*(q3-q1), q3 + k*(q3-q1)]
+//
+// where q1 and q3 are as computed by Quartiles.
+// The conventional value for k is 1.5;
+// larger values flag only more extreme elements.
+// If there are no outliers, the result is empty.
+//
+// The input slice is not modified.
+func OutlierIndices[T Number](s []T, k float64) []int {
+	q1, _, q3 := Quartiles(s)
+	lo, hi := q1-k*(q3-q1), q3+k*(q3-q1)
+
+	var result []int
+	for i, val := range s {
+		if v := float64(val); v < lo || v > hi {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// Variance returns the population variance of the elements of s:
+// the mean of the squared differences from their mean.
+// If s is empty, the result is NaN.
+//
+// It is computed in a single pass using Welford's algorithm,
+// which avoids the loss of precision of the naive sum-of-squares formula.
+func Variance[T Number](s []T) float64 {
+	n, m2 := welford(s)
+	if n == 0 {
+		return math.NaN()
+	}
+	return m2 / float64(n)
+}
+
+// SampleVariance returns the sample variance of the elements of s,
+// which is like the population variance (see Variance)
+// but divides by len(s)-1 instead of len(s)
+// (Bessel's correction).
+// If s has fewer than two elements, the result is NaN.
+func SampleVariance[T Number](s []T) float64 {
+	n, m2 := welford(s)
+	if n < 2 {
+		return math.NaN()
+	}
+	return m2 / float64(n-1)
+}
+
+// StdDev returns the population standard deviation of the elements of s,
+// i.e. the square root of Variance(s).
+// If s is empty, the result is NaN.
+func StdDev[T Number](s []T) float64 {
+	return math.Sqrt(Variance(s))
+}
+
+// SampleStdDev returns the sample standard deviation of the elements of s,
+// i.e. the square root of SampleVariance(s).
+// If s has fewer than two elements, the result is NaN.
+func SampleStdDev[T Number](s []T) float64 {
+	return math.Sqrt(SampleVariance(s))
+}
+
+// welford returns the number of elements in s
+// and the sum of their squared differences from the mean,
+// computed in one pass using Welford's algorithm.
+func welford[T Number](s []T) (n int, m2 float64) {
+	var mean float64
+	for _, val := range s {
+		n++
+		x := float64(val)
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+	return n, m2
+}
+
+// CDF returns the fraction of the elements of sortedSample that are <= v.
+// This is the empirical cumulative distribution function of the sample, evaluated at v.
+// It uses binary search, taking time proportional to log(len(sortedSample)).
+// If sortedSample is empty, or v is NaN, the result is NaN.
+//
+// The input slice must be sorted in ascending order;
+// otherwise the result is not meaningful.
+func CDF[T Number](sortedSample []T, v T) float64 {
+	if len(sortedSample) == 0 || v != v {
+		return math.NaN()
+	}
+	n := sort.Search(len(sortedSample), func(i int) bool { return sortedSample[i] > v })
+	return float64(n) / float64(len(sortedSample))
+}
+
+// Quantile returns the p'th quantile of sortedSample,
+// where 0 <= p <= 1,
+// interpolating linearly between the closest ranks as described for Quartiles.
+// It is approximately the inverse of CDF.
+// If sortedSample is empty, or p is outside [0, 1] (or NaN), the result is NaN.
+//
+// The input slice must be sorted in ascending order;
+// otherwise the result is not meaningful.
+func Quantile[T Number](sortedSample []T, p float64) float64 {
+	if !(p >= 0 && p <= 1) {
+		return math.NaN()
+	}
+	return quantileSorted(sortedSample, p)
+}
+
+// sortedFloats returns the elements of s converted to float64 and sorted in ascending order.
+func sortedFloats[T Number](s []T) []float64 {
+	result := make([]float64, len(s))
+	for i, val := range s {
+		result[i] = float64(val)
+	}
+	sort.Float64s(result)
+	return result
+}
+
+// quantileSorted returns the p'th quantile (0 <= p <= 1) of the sorted values in s,
+// interpolating linearly between the closest ranks.
+// If s is empty, the result is NaN.
+func quantileSorted[T Number](s []T, p float64) float64 {
+	if len(s) == 0 {
+		return math.NaN()
+	}
+	pos := p * float64(len(s)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	return float64(s[lo]) + (float64(s[hi])-float64(s[lo]))*frac
+}
@@ -0,0 +1,167 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestQuartiles(t *testing.T) {
+	cases := []struct {
+		inp                    []int
+		wantQ1, wantQ2, wantQ3 float64
+	}{{
+		inp: []int{7}, wantQ1: 7, wantQ2: 7, wantQ3: 7,
+	}, {
+		inp: []int{5, 1, 4, 2, 3}, wantQ1: 2, wantQ2: 3, wantQ3: 4,
+	}, {
+		inp: []int{4, 3, 2, 1}, wantQ1: 1.75, wantQ2: 2.5, wantQ3: 3.25,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			orig := append([]int{}, tc.inp...)
+			q1, q2, q3 := Quartiles(tc.inp)
+			if q1 != tc.wantQ1 || q2 != tc.wantQ2 || q3 != tc.wantQ3 {
+				t.Errorf("got %v, %v, %v; want %v, %v, %v", q1, q2, q3, tc.wantQ1, tc.wantQ2, tc.wantQ3)
+			}
+			if got, want := IQR(tc.inp), tc.wantQ3-tc.wantQ1; got != want {
+				t.Errorf("got IQR %v, want %v", got, want)
+			}
+			for j := range orig {
+				if tc.inp[j] != orig[j] {
+					t.Fatalf("input was modified: %v", tc.inp)
+				}
+			}
+		})
+	}
+
+	if q1, q2, q3 := Quartiles([]int(nil)); !math.IsNaN(q1) || !math.IsNaN(q2) || !math.IsNaN(q3) {
+		t.Errorf("got %v, %v, %v for empty input, want NaNs", q1, q2, q3)
+	}
+}
+
+func TestOutlierIndices(t *testing.T) {
+	cases := []struct {
+		inp  []int
+		k    float64
+		want []int
+	}{{
+		inp: nil, k: 1.5, want: nil,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, k: 1.5, want: nil,
+	}, {
+		// q1 = 3, q3 = 5, so the fences are [0, 8].
+		inp: []int{-10, 3, 4, 5, 3, 4, 5, 100}, k: 1.5, want: []int{0, 7},
+	}, {
+		// With k = 0 the fences are [3, 5].
+		inp: []int{2, 3, 4, 5, 6}, k: 0, want: []int{0, 4},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := OutlierIndices(tc.inp, tc.k)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVariance(t *testing.T) {
+	inp := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	if got := Variance(inp); got != 4 {
+		t.Errorf("got variance %v, want 4", got)
+	}
+	if got := StdDev(inp); got != 2 {
+		t.Errorf("got standard deviation %v, want 2", got)
+	}
+	if got, want := SampleVariance(inp), 32.0/7; math.Abs(got-want) > 1e-12 {
+		t.Errorf("got sample variance %v, want %v", got, want)
+	}
+	if got, want := SampleStdDev(inp), math.Sqrt(32.0/7); math.Abs(got-want) > 1e-12 {
+		t.Errorf("got sample standard deviation %v, want %v", got, want)
+	}
+
+	if got := Variance([]int{3}); got != 0 {
+		t.Errorf("got variance %v for a single element, want 0", got)
+	}
+	if got := Variance([]int(nil)); !math.IsNaN(got) {
+		t.Errorf("got variance %v for empty input, want NaN", got)
+	}
+	if got := SampleVariance([]int{3}); !math.IsNaN(got) {
+		t.Errorf("got sample variance %v for a single element, want NaN", got)
+	}
+}
+
+func TestCDF(t *testing.T) {
+	sample := []int{1, 2, 2, 3, 5}
+
+	cases := []struct {
+		v    int
+		want float64
+	}{{
+		v: 0, want: 0,
+	}, {
+		v: 1, want: 0.2,
+	}, {
+		v: 2, want: 0.6,
+	}, {
+		v: 4, want: 0.8,
+	}, {
+		v: 5, want: 1,
+	}, {
+		v: 6, want: 1,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := CDF(sample, tc.v); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if got := CDF([]int(nil), 1); !math.IsNaN(got) {
+		t.Errorf("got %v for empty sample, want NaN", got)
+	}
+	if got := CDF([]float64{1, 2, 3}, math.NaN()); !math.IsNaN(got) {
+		t.Errorf("got %v for NaN v, want NaN", got)
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	sample := []int{10, 20, 30, 40, 50}
+
+	cases := []struct {
+		p    float64
+		want float64
+	}{{
+		p: 0, want: 10,
+	}, {
+		p: 0.5, want: 30,
+	}, {
+		p: 0.125, want: 15,
+	}, {
+		p: 1, want: 50,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := Quantile(sample, tc.p); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if got := Quantile(sample, 1.5); !math.IsNaN(got) {
+		t.Errorf("got %v for p out of range, want NaN", got)
+	}
+	if got := Quantile(sample, math.NaN()); !math.IsNaN(got) {
+		t.Errorf("got %v for NaN p, want NaN", got)
+	}
+	if got := Quantile([]int(nil), 0.5); !math.IsNaN(got) {
+		t.Errorf("got %v for empty sample, want NaN", got)
+	}
+}
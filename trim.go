@@ -0,0 +1,24 @@
+package slices
+
+// TrimRun returns s with any leading and trailing elements equal to v removed.
+// It is like bytes.Trim with a single value.
+//
+// The result is a subslice of s, not a copy.
+//
+// Example: TrimRun([0, 0, 1, 0, 2, 0], 0) -> [1, 0, 2]
+func TrimRun[T comparable](s []T, v T) []T {
+	return TrimRunFunc(s, func(val T) bool { return val == v })
+}
+
+// TrimRunFunc returns s with any leading and trailing elements for which pred returns true removed.
+//
+// The result is a subslice of s, not a copy.
+func TrimRunFunc[T any](s []T, pred func(T) bool) []T {
+	for len(s) > 0 && pred(s[0]) {
+		s = s[1:]
+	}
+	for len(s) > 0 && pred(s[len(s)-1]) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,30 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTrimRun(t *testing.T) {
+	cases := []struct {
+		inp, want []int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []int{0, 0, 1, 0, 2, 0}, want: []int{1, 0, 2},
+	}, {
+		inp: []int{1, 2}, want: []int{1, 2},
+	}, {
+		inp: []int{0, 0}, want: []int{},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := TrimRun(tc.inp, 0)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package slices
+
+// UniqIndices returns, in order, the indices of the elements of s
+// that are not duplicates of some earlier element.
+//
+// Selecting those indices from s deduplicates it, keeping the first occurrence of each value.
+// Selecting the same indices from a slice parallel to s
+// deduplicates it consistently with s.
+//
+// Example: UniqIndices([a, b, a, c, b]) -> [0, 1, 3]
+func UniqIndices[T comparable](s []T) []int {
+	var (
+		result []int
+		seen   = make(map[T]struct{})
+	)
+	for i, val := range s {
+		if _, ok := seen[val]; ok {
+			continue
+		}
+		seen[val] = struct{}{}
+		result = append(result, i)
+	}
+	return result
+}
+
+// AppendUnique appends to s those of vals that are not already present,
+// either in s or earlier in vals.
+// It returns the result.
+// This allows a slice to serve as a small ordered set.
+//
+// Each value is checked by scanning the slice,
+// so this takes time proportional to len(s)*len(vals).
+// For large sets, a map is a better choice.
+//
+// Example: AppendUnique([a, b], b, c, c) -> [a, b, c]
+func AppendUnique[T comparable](s []T, vals ...T) []T {
+outer:
+	for _, val := range vals {
+		for _, elem := range s {
+			if elem == val {
+				continue outer
+			}
+		}
+		s = append(s, val)
+	}
+	return s
+}
+
+// UniqEpsilon returns a new slice containing the elements of s in order,
+// omitting any element that is within epsilon of an element already kept.
+//
+// Each candidate is compared against every value kept so far,
+// so which elements survive depends on their order in s
+// (e.g. with epsilon 1, [1, 2, 3] yields [1, 3] but [2, 1, 3] yields [2]),
+// and this takes time proportional to len(s) times the length of the result.
+func UniqEpsilon[T ~float32 | ~float64](s []T, epsilon T) []T {
+	var result []T
+
+outer:
+	for _, val := range s {
+		for _, kept := range result {
+			if d := val - kept; d <= epsilon && -d <= epsilon {
+				continue outer
+			}
+		}
+		result = append(result, val)
+	}
+	return result
+}
+
+// SuppressWithin returns a new slice containing the elements of s in order,
+// omitting any element equal to one of the window elements of s preceding it.
+// (Those preceding elements count whether or not they were themselves omitted.)
+// A value may therefore reappear in the result
+// once window positions have passed without it.
+// If window <= 0, nothing is omitted.
+//
+// SuppressWithin keeps track of the values in the current window in a map,
+// so it uses memory proportional to min(window, len(s)).
+//
+// Example: SuppressWithin([a, a, b, a, c, c, a], 2) -> [a, b, c, a]
+func SuppressWithin[T comparable](s []T, window int) []T {
+	var (
+		result []T
+		counts = make(map[T]int)
+	)
+	for i, val := range s {
+		if counts[val] == 0 || window <= 0 {
+			result = append(result, val)
+		}
+		if window <= 0 {
+			continue
+		}
+		counts[val]++
+		if j := i - window; j >= 0 {
+			old := s[j]
+			if counts[old]--; counts[old] == 0 {
+				delete(counts, old)
+			}
+		}
+	}
+	return result
+}
+
+// CompactEpsilon collapses each run of adjacent elements of s
+// lying within epsilon of the run's first element
+// down to that first element,
+// and returns the result.
+// The vacated positions at the end of s are set to the zero value.
+//
+// Each element is compared against the first element of the current run,
+// not against the element just before it,
+// so a slowly drifting sequence starts a new run
+// once it strays more than epsilon from where the run began.
+//
+// The input slice is modified.
+//
+// Example: CompactEpsilon([1, 1.25, 1.5, 2, 4], 0.5) -> [1, 2, 4]
+func CompactEpsilon[T ~float32 | ~float64](s []T, epsilon T) []T {
+	if len(s) == 0 {
+		return s
+	}
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if d := s[i] - s[n-1]; d <= epsilon && -d <= epsilon {
+			continue
+		}
+		s[n] = s[i]
+		n++
+	}
+	clear(s[n:])
+	return s[:n]
+}
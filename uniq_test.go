@@ -0,0 +1,135 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUniqIndices(t *testing.T) {
+	cases := []struct {
+		inp  []string
+		want []int
+	}{{
+		inp: nil, want: nil,
+	}, {
+		inp: []string{"a", "b", "a", "c", "b"}, want: []int{0, 1, 3},
+	}, {
+		inp: []string{"a", "a", "a"}, want: []int{0},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := UniqIndices(tc.inp)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAppendUnique(t *testing.T) {
+	cases := []struct {
+		inp, vals, want []int
+	}{{
+		inp: nil, vals: nil, want: nil,
+	}, {
+		inp: nil, vals: []int{1, 1, 2}, want: []int{1, 2},
+	}, {
+		inp: []int{1, 2}, vals: []int{2, 3, 3, 1, 4}, want: []int{1, 2, 3, 4},
+	}, {
+		inp: []int{1, 2}, vals: []int{2, 1}, want: []int{1, 2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := AppendUnique(tc.inp, tc.vals...)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUniqEpsilon(t *testing.T) {
+	cases := []struct {
+		inp     []float64
+		epsilon float64
+		want    []float64
+	}{{
+		inp: nil, epsilon: 1, want: nil,
+	}, {
+		inp: []float64{1, 2, 3}, epsilon: 1, want: []float64{1, 3},
+	}, {
+		inp: []float64{2, 1, 3}, epsilon: 1, want: []float64{2},
+	}, {
+		inp: []float64{0.1 + 0.2, 0.3, 0.5}, epsilon: 1e-9, want: []float64{0.1 + 0.2, 0.5},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := UniqEpsilon(tc.inp, tc.epsilon)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSuppressWithin(t *testing.T) {
+	cases := []struct {
+		inp    string
+		window int
+		want   string
+	}{{
+		inp: "", window: 2, want: "",
+	}, {
+		inp: "aabacca", window: 2, want: "abca",
+	}, {
+		inp: "aabacca", window: 1, want: "abaca",
+	}, {
+		inp: "aabacca", window: 0, want: "aabacca",
+	}, {
+		inp: "abcabc", window: 10, want: "abc",
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := SuppressWithin([]byte(tc.inp), tc.window)
+			if string(got) != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompactEpsilon(t *testing.T) {
+	cases := []struct {
+		inp     []float64
+		epsilon float64
+		want    []float64
+	}{{
+		inp: nil, epsilon: 0.1, want: nil,
+	}, {
+		inp: []float64{1, 1.25, 1.5, 2, 4}, epsilon: 0.5, want: []float64{1, 2, 4},
+	}, {
+		inp: []float64{1, 3, 1}, epsilon: 0.5, want: []float64{1, 3, 1},
+	}, {
+		inp: []float64{2, 2, 2}, epsilon: 0, want: []float64{2},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := CompactEpsilon(tc.inp, tc.epsilon)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			for _, val := range tc.inp[len(got):] {
+				if val != 0 {
+					t.Errorf("got nonzero tail %v", tc.inp[len(got):])
+					break
+				}
+			}
+		})
+	}
+}
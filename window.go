@@ -0,0 +1,129 @@
+package slices
+
+// AnyWindow tells whether pred returns true for any sliding window of s of length size.
+// Windows are tested in order, from s[0:size] to s[len(s)-size:],
+// and AnyWindow stops at the first one for which pred returns true.
+//
+// If size is greater than len(s), or less than 1,
+// there are no windows and the result is false.
+//
+// Each window is a subslice of s, not a copy.
+// The pred function must not modify or retain it.
+func AnyWindow[T any](s []T, size int, pred func(window []T) bool) bool {
+	if size < 1 {
+		return false
+	}
+	for i := 0; i+size <= len(s); i++ {
+		if pred(s[i : i+size]) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllWindow tells whether pred returns true for every sliding window of s of length size.
+// Windows are tested in order, from s[0:size] to s[len(s)-size:],
+// and AllWindow stops at the first one for which pred returns false.
+//
+// If size is greater than len(s), or less than 1,
+// there are no windows and the result is (vacuously) true.
+//
+// Each window is a subslice of s, not a copy.
+// The pred function must not modify or retain it.
+func AllWindow[T any](s []T, size int, pred func(window []T) bool) bool {
+	if size < 1 {
+		return true
+	}
+	for i := 0; i+size <= len(s); i++ {
+		if !pred(s[i : i+size]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DistinctInWindows returns the number of distinct values
+// in each sliding window of s of length size,
+// from s[0:size] to s[len(s)-size:].
+// If size is greater than len(s), or less than 1,
+// there are no windows and the result is empty.
+//
+// Rather than examining each window afresh,
+// DistinctInWindows keeps a count of each value in the current window,
+// adding the element that enters as the window slides forward
+// and removing the one that leaves,
+// so it takes time proportional to len(s) in total.
+//
+// Example: DistinctInWindows([a, b, a, c, c], 3) -> [2, 3, 2]
+func DistinctInWindows[T comparable](s []T, size int) []int {
+	if size < 1 || size > len(s) {
+		return nil
+	}
+	var (
+		result = make([]int, 0, len(s)-size+1)
+		counts = make(map[T]int)
+	)
+	for i, val := range s {
+		counts[val]++
+		if j := i - size; j >= 0 {
+			old := s[j]
+			if counts[old]--; counts[old] == 0 {
+				delete(counts, old)
+			}
+		}
+		if i >= size-1 {
+			result = append(result, len(counts))
+		}
+	}
+	return result
+}
+
+// Stencil calls f on each element of s together with its neighbors,
+// returning a new slice of the results,
+// the same length as s.
+//
+// At the boundaries, the missing neighbor is clamped to the edge element:
+// for s[0], prev is s[0],
+// and for s[len(s)-1], next is s[len(s)-1].
+// (For a single-element slice, all three arguments are s[0].)
+// See StencilInterior for a variant that skips the endpoints instead.
+//
+// Example: Stencil([1, 2, 4], sum3) -> [4, 7, 10]
+func Stencil[T, U any](s []T, f func(prev, cur, next T) U) []U {
+	if len(s) == 0 {
+		return nil
+	}
+	result := make([]U, len(s))
+	for i, cur := range s {
+		prev, next := cur, cur
+		if i > 0 {
+			prev = s[i-1]
+		}
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+		result[i] = f(prev, cur, next)
+	}
+	return result
+}
+
+// StencilInterior calls f on each element of s that has two neighbors,
+// together with those neighbors,
+// returning a new slice of the results.
+// The endpoints of s are not passed to f as cur,
+// so the result is two elements shorter than s
+// (or empty if s has fewer than three elements),
+// and result[i] corresponds to s[i+1].
+// See Stencil for a variant that clamps at the boundaries instead.
+//
+// Example: StencilInterior([1, 2, 4, 8], sum3) -> [7, 14]
+func StencilInterior[T, U any](s []T, f func(prev, cur, next T) U) []U {
+	if len(s) < 3 {
+		return nil
+	}
+	result := make([]U, len(s)-2)
+	for i := range result {
+		result[i] = f(s[i], s[i+1], s[i+2])
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAnyAllWindow(t *testing.T) {
+	allOdd := func(w []int) bool {
+		for _, n := range w {
+			if n%2 == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	cases := []struct {
+		inp              []int
+		size             int
+		wantAny, wantAll bool
+	}{{
+		inp: nil, size: 1, wantAny: false, wantAll: true,
+	}, {
+		inp: []int{1, 3}, size: 3, wantAny: false, wantAll: true,
+	}, {
+		inp: []int{1, 2, 3, 5, 4}, size: 2, wantAny: true, wantAll: false,
+	}, {
+		inp: []int{1, 2, 3, 4, 5}, size: 2, wantAny: false, wantAll: false,
+	}, {
+		inp: []int{1, 3, 5, 7}, size: 2, wantAny: true, wantAll: true,
+	}, {
+		inp: []int{1, 3, 5, 7}, size: 4, wantAny: true, wantAll: true,
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := AnyWindow(tc.inp, tc.size, allOdd); got != tc.wantAny {
+				t.Errorf("AnyWindow: got %v, want %v", got, tc.wantAny)
+			}
+			if got := AllWindow(tc.inp, tc.size, allOdd); got != tc.wantAll {
+				t.Errorf("AllWindow: got %v, want %v", got, tc.wantAll)
+			}
+		})
+	}
+}
+
+func TestDistinctInWindows(t *testing.T) {
+	cases := []struct {
+		inp  string
+		size int
+		want []int
+	}{{
+		inp: "", size: 1, want: nil,
+	}, {
+		inp: "ab", size: 3, want: nil,
+	}, {
+		inp: "abacc", size: 3, want: []int{2, 3, 2},
+	}, {
+		inp: "aaaa", size: 2, want: []int{1, 1, 1},
+	}, {
+		inp: "abcd", size: 1, want: []int{1, 1, 1, 1},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := DistinctInWindows([]byte(tc.inp), tc.size)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStencil(t *testing.T) {
+	sum3 := func(a, b, c int) int { return a + b + c }
+
+	cases := []struct {
+		inp                     []int
+		wantClamp, wantInterior []int
+	}{{
+		inp: nil, wantClamp: nil, wantInterior: nil,
+	}, {
+		inp: []int{5}, wantClamp: []int{15}, wantInterior: nil,
+	}, {
+		inp: []int{1, 2, 4}, wantClamp: []int{4, 7, 10}, wantInterior: []int{7},
+	}, {
+		inp: []int{1, 2, 4, 8}, wantClamp: []int{4, 7, 14, 20}, wantInterior: []int{7, 14},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			if got := Stencil(tc.inp, sum3); !reflect.DeepEqual(got, tc.wantClamp) {
+				t.Errorf("Stencil: got %v, want %v", got, tc.wantClamp)
+			}
+			if got := StencilInterior(tc.inp, sum3); !reflect.DeepEqual(got, tc.wantInterior) {
+				t.Errorf("StencilInterior: got %v, want %v", got, tc.wantInterior)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package slices
+
+// Slice is a wrapper around a Go slice
+// with methods mirroring the functions in this package,
+// so that calls can be chained:
+//
+//	s.Insert(1, x).RemoveN(0, 1)
+//
+// A Slice aliases the same underlying array as the []T it was made from,
+// so the same caveats apply as for the corresponding functions:
+// methods that modify the slice modify the original array,
+// and their results must be used in place of the receiver
+// (as with Go's builtin append).
+type Slice[T any] []T
+
+// Get gets the idx'th element of s.
+// See the Get function.
+func (s Slice[T]) Get(idx int) T {
+	return Get(s, idx)
+}
+
+// Put puts a given value into the idx'th location in s and returns s.
+// See the Put function.
+func (s Slice[T]) Put(idx int, val T) Slice[T] {
+	Put(s, idx, val)
+	return s
+}
+
+// Append appends the given values to s and returns the result.
+// See the Append function.
+func (s Slice[T]) Append(vals ...T) Slice[T] {
+	return Append(s, vals...)
+}
+
+// Insert inserts the given values at the idx'th location in s and returns the result.
+// See the Insert function.
+func (s Slice[T]) Insert(idx int, vals ...T) Slice[T] {
+	return Insert(s, idx, vals...)
+}
+
+// ReplaceN replaces the n values of s beginning at position idx with the given values.
+// See the ReplaceN function.
+func (s Slice[T]) ReplaceN(idx, n int, vals ...T) Slice[T] {
+	return ReplaceN(s, idx, n, vals...)
+}
+
+// ReplaceTo replaces the values of s beginning at from and ending before to with the given values.
+// See the ReplaceTo function.
+func (s Slice[T]) ReplaceTo(from, to int, vals ...T) Slice[T] {
+	return ReplaceTo(s, from, to, vals...)
+}
+
+// RemoveN removes n items from s beginning at position idx and returns the result.
+// See the RemoveN function.
+func (s Slice[T]) RemoveN(idx, n int) Slice[T] {
+	return RemoveN(s, idx, n)
+}
+
+// RemoveTo removes items from s beginning at position from and ending before position to.
+// See the RemoveTo function.
+func (s Slice[T]) RemoveTo(from, to int) Slice[T] {
+	return RemoveTo(s, from, to)
+}
+
+// Prefix returns s up to but not including position idx.
+// See the Prefix function.
+func (s Slice[T]) Prefix(idx int) Slice[T] {
+	return Prefix(s, idx)
+}
+
+// Suffix returns s excluding elements before position idx.
+// See the Suffix function.
+func (s Slice[T]) Suffix(idx int) Slice[T] {
+	return Suffix(s, idx)
+}
+
+// SliceN returns n elements of s beginning at position idx.
+// See the SliceN function.
+func (s Slice[T]) SliceN(idx, n int) Slice[T] {
+	return SliceN(s, idx, n)
+}
+
+// SliceTo returns the elements of s beginning at position from and ending before position to.
+// See the SliceTo function.
+func (s Slice[T]) SliceTo(from, to int) Slice[T] {
+	return SliceTo(s, from, to)
+}
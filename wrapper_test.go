@@ -0,0 +1,40 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSliceType(t *testing.T) {
+	s := Slice[int]{1, 2, 3}
+
+	got := s.Insert(1, 4, 5).RemoveN(0, 1).Put(-1, 6)
+	want := Slice[int]{4, 5, 2, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if v := got.Get(-2); v != 2 {
+		t.Errorf("got %d, want 2", v)
+	}
+
+	got = got.Append(7).ReplaceTo(1, 3, 8).Suffix(1).Prefix(-1)
+	want = Slice[int]{8, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	s = Slice[int]{1, 2, 3, 4, 5, 6}
+	got = s.ReplaceN(1, 2, 7).RemoveTo(-2, -1).SliceN(1, 3)
+	want = Slice[int]{7, 4, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	s = Slice[int]{1, 2, 3, 4, 5}
+	got = s.SliceTo(1, -1)
+	want = Slice[int]{2, 3, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
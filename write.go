@@ -0,0 +1,39 @@
+package slices
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteChunks divides s into consecutive chunks of length size
+// (the last of which may be shorter),
+// encodes each chunk with encode,
+// and writes the resulting bytes to w.
+// Each chunk is written before the next is encoded,
+// so the output for all of s is never held in memory at once.
+//
+// If a call to encode or a write to w fails,
+// WriteChunks stops and returns the error,
+// wrapped with the position of the chunk that caused it.
+// The output for earlier chunks will already have been written to w,
+// and in the case of a write error,
+// some of the output for the failing chunk may have been too.
+//
+// It is an error for size to be less than 1.
+func WriteChunks[T any](w io.Writer, s []T, size int, encode func([]T) ([]byte, error)) error {
+	if size < 1 {
+		return fmt.Errorf("chunk size %d is not positive", size)
+	}
+	for i := 0; len(s) > 0; i++ {
+		n := min(size, len(s))
+		buf, err := encode(s[:n])
+		if err != nil {
+			return fmt.Errorf("encoding chunk %d: %w", i, err)
+		}
+		if _, err := w.Write(buf); err != nil {
+			return fmt.Errorf("writing chunk %d: %w", i, err)
+		}
+		s = s[n:]
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package slices
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestWriteChunks(t *testing.T) {
+	encode := func(chunk []int) ([]byte, error) {
+		return []byte(fmt.Sprintln(chunk)), nil
+	}
+
+	buf := new(bytes.Buffer)
+	if err := WriteChunks(buf, []int{1, 2, 3, 4, 5}, 2, encode); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "[1 2]\n[3 4]\n[5]\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	e := errors.New("error")
+	buf.Reset()
+	err := WriteChunks(buf, []int{1, 2, 3, 4, 5}, 2, func(chunk []int) ([]byte, error) {
+		if chunk[0] == 3 {
+			return nil, e
+		}
+		return encode(chunk)
+	})
+	if !errors.Is(err, e) {
+		t.Errorf("got %v, want error %v", err, e)
+	}
+	if got, want := buf.String(), "[1 2]\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if err := WriteChunks(buf, []int{1}, 0, encode); err == nil {
+		t.Error("got no error for size 0, want one")
+	}
+}
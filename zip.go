@@ -0,0 +1,64 @@
+package slices
+
+// ZipInto combines corresponding elements of a and b with construct,
+// returning a new slice of the results.
+// This is useful for building a slice of structs
+// from parallel slices holding their fields.
+// If a and b have different lengths,
+// the result is as long as the shorter one.
+//
+// Example: ZipInto([1, 2, 3], [4, 5], makePoint) -> [{1, 4}, {2, 5}]
+func ZipInto[A, B, C any](a []A, b []B, construct func(A, B) C) []C {
+	n := min(len(a), len(b))
+	if n == 0 {
+		return nil
+	}
+	result := make([]C, n)
+	for i := range n {
+		result[i] = construct(a[i], b[i])
+	}
+	return result
+}
+
+// ZipToMap returns a map from each element of keys to the corresponding element of vals.
+// If keys and vals have different lengths,
+// the extra elements of the longer one are ignored.
+// If a key appears more than once,
+// the value corresponding to its last appearance is the one in the map.
+//
+// Example: ZipToMap([a, b, a], [1, 2, 3, 4]) -> {a: 3, b: 2}
+func ZipToMap[K comparable, V any](keys []K, vals []V) map[K]V {
+	n := min(len(keys), len(vals))
+	result := make(map[K]V, n)
+	for i := range n {
+		result[keys[i]] = vals[i]
+	}
+	return result
+}
+
+// ZipWithLongest combines corresponding elements of a and b with f,
+// returning a new slice of the results.
+// The result is as long as the longer of a and b.
+// Past the end of the shorter one,
+// defA (if a is shorter) or defB (if b is shorter)
+// is passed to f in place of the missing element.
+//
+// Example: ZipWithLongest([1, 2, 3], [10], 0, 0, add) -> [11, 2, 3]
+func ZipWithLongest[A, B, C any](a []A, b []B, defA A, defB B, f func(A, B) C) []C {
+	n := max(len(a), len(b))
+	if n == 0 {
+		return nil
+	}
+	result := make([]C, n)
+	for i := range n {
+		x, y := defA, defB
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		result[i] = f(x, y)
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestZipInto(t *testing.T) {
+	type point struct{ x, y int }
+	makePoint := func(x, y int) point { return point{x: x, y: y} }
+
+	cases := []struct {
+		a, b []int
+		want []point
+	}{{
+		a: nil, b: nil, want: nil,
+	}, {
+		a: []int{1, 2}, b: []int{3, 4}, want: []point{{1, 3}, {2, 4}},
+	}, {
+		a: []int{1, 2, 3}, b: []int{4, 5}, want: []point{{1, 4}, {2, 5}},
+	}, {
+		a: []int{1}, b: []int{4, 5}, want: []point{{1, 4}},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ZipInto(tc.a, tc.b, makePoint)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestZipToMap(t *testing.T) {
+	cases := []struct {
+		keys []string
+		vals []int
+		want map[string]int
+	}{{
+		keys: nil, vals: nil, want: map[string]int{},
+	}, {
+		keys: []string{"a", "b", "a"}, vals: []int{1, 2, 3, 4}, want: map[string]int{"a": 3, "b": 2},
+	}, {
+		keys: []string{"a", "b", "c"}, vals: []int{1}, want: map[string]int{"a": 1},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ZipToMap(tc.keys, tc.vals)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestZipWithLongest(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	cases := []struct {
+		a, b       []int
+		defA, defB int
+		want       []int
+	}{{
+		a: nil, b: nil, want: nil,
+	}, {
+		a: []int{1, 2, 3}, b: []int{10}, want: []int{11, 2, 3},
+	}, {
+		a: []int{1}, b: []int{10, 20, 30}, defA: 100, want: []int{11, 120, 130},
+	}, {
+		a: []int{1, 2}, b: nil, defB: 5, want: []int{6, 7},
+	}}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("case_%02d", i+1), func(t *testing.T) {
+			got := ZipWithLongest(tc.a, tc.b, tc.defA, tc.defB, add)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}